@@ -30,8 +30,12 @@ type BackupSpec struct {
 	BackupName *string `json:"backupName"`
 	// The name of the table. You can also provide the Amazon Resource Name (ARN)
 	// of the table in this parameter.
-	// +kubebuilder:validation:Required
-	TableName *string `json:"tableName"`
+	TableName *string `json:"tableName,omitempty"`
+	// Reference field for TableName
+	TableRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"tableRef,omitempty"`
+	// A list of key-value pairs to label the backup once it becomes available.
+	// Backups do not inherit the source table's tags.
+	Tags []*Tag `json:"tags,omitempty"`
 }
 
 // BackupStatus defines the observed state of Backup
@@ -73,6 +77,13 @@ type BackupStatus struct {
 	//    * AWS_BACKUP - On-demand backup created by you from Backup service.
 	// +kubebuilder:validation:Optional
 	BackupType *string `json:"backupType,omitempty"`
+	// Contains the details of the table when the backup was created.
+	// +kubebuilder:validation:Optional
+	SourceTableDetails *SourceTableDetails `json:"sourceTableDetails,omitempty"`
+	// Contains the details of the features enabled on the table when the backup
+	// was created. For example, LSIs, GSIs, streams, TTL.
+	// +kubebuilder:validation:Optional
+	SourceTableFeatureDetails *SourceTableFeatureDetails `json:"sourceTableFeatureDetails,omitempty"`
 }
 
 // Backup is the Schema for the Backups API
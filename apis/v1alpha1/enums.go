@@ -145,12 +145,12 @@ const (
 	ExportFormat_ION           ExportFormat = "ION"
 )
 
-type ExportStatus string
+type ExportStatus_SDK string
 
 const (
-	ExportStatus_COMPLETED   ExportStatus = "COMPLETED"
-	ExportStatus_FAILED      ExportStatus = "FAILED"
-	ExportStatus_IN_PROGRESS ExportStatus = "IN_PROGRESS"
+	ExportStatus_SDK_COMPLETED   ExportStatus_SDK = "COMPLETED"
+	ExportStatus_SDK_FAILED      ExportStatus_SDK = "FAILED"
+	ExportStatus_SDK_IN_PROGRESS ExportStatus_SDK = "IN_PROGRESS"
 )
 
 type ExportType string
@@ -218,6 +218,13 @@ const (
 	KeyType_RANGE KeyType = "RANGE"
 )
 
+type MultiRegionConsistency string
+
+const (
+	MultiRegionConsistency_EVENTUAL MultiRegionConsistency = "EVENTUAL"
+	MultiRegionConsistency_STRONG   MultiRegionConsistency = "STRONG"
+)
+
 type PointInTimeRecoveryStatus string
 
 const (
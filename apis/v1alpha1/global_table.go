@@ -62,6 +62,8 @@ type GlobalTableStatus struct {
 	//    * ACTIVE - The global table is ready for use.
 	// +kubebuilder:validation:Optional
 	GlobalTableStatus *string `json:"globalTableStatus,omitempty"`
+	// +kubebuilder:validation:Optional
+	ReplicationGroupDescriptions []*ReplicaDescription `json:"replicationGroupDescriptions,omitempty"`
 }
 
 // GlobalTable is the Schema for the GlobalTables API
@@ -70,6 +70,14 @@ type TableSpec struct {
 	//   - ProvisionedThroughput - The provisioned throughput settings for the
 	//     global secondary index, consisting of read and write capacity units.
 	GlobalSecondaryIndexes []*GlobalSecondaryIndex `json:"globalSecondaryIndexes,omitempty"`
+	// The S3 source location and format options for an import into the table.
+	// When set, the table is created with ImportTable instead of CreateTable,
+	// with the table creation parameters derived from the rest of the spec. The
+	// import runs asynchronously; its progress is surfaced in Status.Import and
+	// the resource is only marked as synced once the import has completed. This
+	// field is only consulted while the table is being created.
+	// +kubebuilder:validation:Optional
+	ImportSourceSpecification *ImportSourceSpecification `json:"importSourceSpecification,omitempty"`
 	// Specifies the attributes that make up the primary key for a table or an index.
 	// The attributes in KeySchema must also be defined in the AttributeDefinitions
 	// array. For more information, see Data Model (https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/DataModel.html)
@@ -103,6 +111,11 @@ type TableSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	// +kubebuilder:validation:Required
 	KeySchema []*KeySchemaElement `json:"keySchema"`
+	// The Kinesis Data Streams destinations that table changes are replicated
+	// to. Each entry names a stream by ARN and optionally the precision of the
+	// approximate creation timestamp attached to each record.
+	// +kubebuilder:validation:Optional
+	KinesisStreamDestinations []*KinesisDataStreamDestination `json:"kinesisStreamDestinations,omitempty"`
 	// One or more local secondary indexes (the maximum is 5) to be created on the
 	// table. Each index is scoped to a given partition key value. There is a 10
 	// GB size limit per partition key value; otherwise, the size of a local secondary
@@ -134,6 +147,27 @@ type TableSpec struct {
 	//
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	LocalSecondaryIndexes []*LocalSecondaryIndex `json:"localSecondaryIndexes,omitempty"`
+	// Specifies the consistency mode for a new global table. This parameter is
+	// only valid when you create a global table by specifying one or more Global
+	// table replicas.
+	//
+	// You can specify one of the following consistency modes:
+	//
+	//   - EVENTUAL: Configures a new global table for multi-Region eventual consistency.
+	//     This is the default consistency mode for global tables.
+	//
+	//   - STRONG: Configures a new global table for multi-Region strong consistency
+	//     (preview). Multi-Region strong consistency (MRSC) is a new DynamoDB global
+	//     tables capability currently available in preview mode. For more information,
+	//     see Global tables multi-Region strong consistency (https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/PreviewFeatures.html#multi-region-strong-consistency-gt).
+	//
+	// If you don't specify this field, the global table consistency mode defaults
+	// to EVENTUAL.
+	MultiRegionConsistency *string `json:"multiRegionConsistency,omitempty"`
+	// Sets the maximum number of read and write units for the specified on-demand
+	// table. If you use this parameter, you must specify MaxReadRequestUnits, MaxWriteRequestUnits,
+	// or both.
+	OnDemandThroughput *OnDemandThroughput `json:"onDemandThroughput,omitempty"`
 	// Represents the provisioned throughput settings for a specified table or index.
 	// The settings can be modified using the UpdateTable operation.
 	//
@@ -143,7 +177,35 @@ type TableSpec struct {
 	// For current minimum and maximum provisioned throughput values, see Service,
 	// Account, and Table Quotas (https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/Limits.html)
 	// in the Amazon DynamoDB Developer Guide.
-	ProvisionedThroughput *ProvisionedThroughput `json:"provisionedThroughput,omitempty"`
+	ProvisionedThroughput *ProvisionedThroughput      `json:"provisionedThroughput,omitempty"`
+	ReplicaAutoScaling    []*ReplicaAutoScalingUpdate `json:"replicaAutoScaling,omitempty"`
+	// An Amazon Web Services resource-based policy document in JSON format that
+	// will be attached to the table.
+	//
+	// When you attach a resource-based policy while creating a table, the policy
+	// application is strongly consistent.
+	//
+	// The maximum size supported for a resource-based policy document is 20 KB.
+	// DynamoDB counts whitespaces when calculating the size of a policy against
+	// this limit.
+	// +kubebuilder:validation:Optional
+	ResourcePolicy *string `json:"resourcePolicy,omitempty"`
+	// Identifies the source table and the point in time to restore it to. When
+	// set, the table is created with RestoreTableToPointInTime instead of
+	// CreateTable, applying the spec's billing mode, provisioned throughput,
+	// global secondary index and SSE settings as restore overrides. Exactly one
+	// of sourceTableARN or sourceTableName must be set, together with either
+	// restoreDateTime or useLatestRestorableTime. This field is only consulted
+	// while the table is being created.
+	// +kubebuilder:validation:Optional
+	RestoreFrom *RestoreTableToPointInTimeSpecification `json:"restoreFrom,omitempty"`
+	// The Amazon Resource Name (ARN) of the on-demand backup to restore the table
+	// from. When set, the table is created with RestoreTableFromBackup instead
+	// of CreateTable, applying the spec's billing mode, provisioned throughput,
+	// global secondary index and SSE settings as restore overrides. This field
+	// cannot be changed once the table has been created.
+	// +kubebuilder:validation:Optional
+	RestoreSourceBackupARN *string `json:"restoreSourceBackupARN,omitempty"`
 	// Represents the settings used to enable server-side encryption.
 	SSESpecification *SSESpecification `json:"sseSpecification,omitempty"`
 	// The settings for DynamoDB Streams on the table. These settings consist of:
@@ -163,9 +225,10 @@ type TableSpec struct {
 	// The table class of the new table. Valid values are STANDARD and STANDARD_INFREQUENT_ACCESS.
 	TableClass *string `json:"tableClass,omitempty"`
 	// The name of the table to create. You can also provide the Amazon Resource
-	// Name (ARN) of the table in this parameter.
-	// +kubebuilder:validation:Required
-	TableName     *string                               `json:"tableName"`
+	// Name (ARN) of the table in this parameter. If omitted, the name of the
+	// Kubernetes object is used.
+	// +kubebuilder:validation:Optional
+	TableName     *string                               `json:"tableName,omitempty"`
 	TableReplicas []*CreateReplicationGroupMemberAction `json:"tableReplicas,omitempty"`
 	// A list of key-value pairs to label the table. For more information, see Tagging
 	// for DynamoDB (https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/Tagging.html).
@@ -173,6 +236,9 @@ type TableSpec struct {
 	// Represents the settings used to enable or disable Time to Live for the specified
 	// table.
 	TimeToLive *TimeToLiveSpecification `json:"timeToLive,omitempty"`
+	// Represents the warm throughput (in read units per second and write units
+	// per second) for creating a table.
+	WarmThroughput *WarmThroughput `json:"warmThroughput,omitempty"`
 }
 
 // TableStatus defines the observed state of Table
@@ -191,16 +257,34 @@ type TableStatus struct {
 	// Contains information about the table archive.
 	// +kubebuilder:validation:Optional
 	ArchivalSummary *ArchivalSummary `json:"archivalSummary,omitempty"`
+	// Contains the details for the read/write capacity mode, including when the
+	// billing mode last changed to PAY_PER_REQUEST.
+	// +kubebuilder:validation:Optional
+	BillingModeSummary *BillingModeSummary `json:"billingModeSummary,omitempty"`
+	// The continuous backups status of the table and the description of its
+	// point in time recovery settings, including whether point in time
+	// recovery is actually enabled on the table.
+	// +kubebuilder:validation:Optional
+	ContinuousBackupsDescription *ContinuousBackupsDescription `json:"continuousBackupsDescription,omitempty"`
 	// The date and time when the table was created, in UNIX epoch time (http://www.epochconverter.com/)
 	// format.
 	// +kubebuilder:validation:Optional
 	CreationDateTime *metav1.Time `json:"creationDateTime,omitempty"`
+	// Indicates whether deletion protection is enabled (true) or disabled (false)
+	// on the table, as reported by DynamoDB.
+	// +kubebuilder:validation:Optional
+	DeletionProtectionEnabled *bool `json:"deletionProtectionEnabled,omitempty"`
 	// +kubebuilder:validation:Optional
 	GlobalSecondaryIndexesDescriptions []*GlobalSecondaryIndexDescription `json:"globalSecondaryIndexesDescriptions,omitempty"`
 	// Represents the version of global tables (https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/GlobalTables.html)
 	// in use, if the table is replicated across Amazon Web Services Regions.
 	// +kubebuilder:validation:Optional
 	GlobalTableVersion *string `json:"globalTableVersion,omitempty"`
+	// The status of the import started by Spec.ImportSourceSpecification,
+	// including the number of items processed so far and the failure code and
+	// message if the import failed.
+	// +kubebuilder:validation:Optional
+	Import *ImportTableDescription `json:"import,omitempty"`
 	// The number of items in the specified table. DynamoDB updates this value approximately
 	// every six hours. Recent changes might not be reflected in this value.
 	// +kubebuilder:validation:Optional
@@ -223,12 +307,43 @@ type TableStatus struct {
 	//    * StreamLabel
 	// +kubebuilder:validation:Optional
 	LatestStreamLabel *string `json:"latestStreamLabel,omitempty"`
+	// +kubebuilder:validation:Optional
+	LocalSecondaryIndexesDescriptions []*LocalSecondaryIndexDescription `json:"localSecondaryIndexesDescriptions,omitempty"`
+	// Indicates one of the following consistency modes for a global table:
+	//
+	//   - EVENTUAL: Indicates that the global table is configured for multi-Region
+	//     eventual consistency.
+	//
+	//   - STRONG: Indicates that the global table is configured for multi-Region
+	//     strong consistency (preview).
+	//
+	// If you don't specify this field, the global table consistency mode defaults
+	// to EVENTUAL.
+	// +kubebuilder:validation:Optional
+	MultiRegionConsistency *string `json:"multiRegionConsistency,omitempty"`
+	// The description of the point in time settings applied to the table,
+	// including the window of time the table can be restored to.
+	// +kubebuilder:validation:Optional
+	PointInTimeRecovery *PointInTimeRecoveryDescription `json:"pointInTimeRecovery,omitempty"`
+	// The provisioned throughput settings for the table, consisting of read and
+	// write capacity units, along with data about increases and decreases.
+	// +kubebuilder:validation:Optional
+	ProvisionedThroughputDescription *ProvisionedThroughputDescription `json:"provisionedThroughputDescription,omitempty"`
+	// +kubebuilder:validation:Optional
+	ReplicaAutoScalingDescriptions []*ReplicaAutoScalingDescription `json:"replicaAutoScalingDescriptions,omitempty"`
 	// Represents replicas of the table.
 	// +kubebuilder:validation:Optional
 	Replicas []*ReplicaDescription `json:"replicas,omitempty"`
 	// Contains details for the restore.
 	// +kubebuilder:validation:Optional
 	RestoreSummary *RestoreSummary `json:"restoreSummary,omitempty"`
+	// The description of the server-side encryption status on the specified
+	// table.
+	// +kubebuilder:validation:Optional
+	SSEDescription *SSEDescription `json:"sseDescription,omitempty"`
+	// Contains details of the table class.
+	// +kubebuilder:validation:Optional
+	TableClassSummary *TableClassSummary `json:"tableClassSummary,omitempty"`
 	// Unique identifier for the table for which the backup was created.
 	//
 	// Regex Pattern: `^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`
@@ -262,6 +377,13 @@ type TableStatus struct {
 	//    information.
 	// +kubebuilder:validation:Optional
 	TableStatus *string `json:"tableStatus,omitempty"`
+	// The description of the Time to Live (TTL) status on the specified table,
+	// including whether the attribute is currently being enabled or disabled.
+	// +kubebuilder:validation:Optional
+	TimeToLiveDescription *TimeToLiveDescription `json:"timeToLiveDescription,omitempty"`
+	// Describes the warm throughput value of the base table.
+	// +kubebuilder:validation:Optional
+	WarmThroughputDescription *TableWarmThroughputDescription `json:"warmThroughputDescription,omitempty"`
 }
 
 // Table is the Schema for the Tables API
@@ -41,13 +41,29 @@ type AttributeDefinition struct {
 	AttributeType *string `json:"attributeType,omitempty"`
 }
 
+// Represents the properties of the scaling policy.
+type AutoScalingPolicyDescription struct {
+	PolicyName *string `json:"policyName,omitempty"`
+	// Represents the properties of a target tracking scaling policy.
+	TargetTrackingScalingPolicyConfiguration *AutoScalingTargetTrackingScalingPolicyConfigurationDescription `json:"targetTrackingScalingPolicyConfiguration,omitempty"`
+}
+
+// Represents the auto scaling policy to be modified.
+type AutoScalingPolicyUpdate struct {
+	PolicyName *string `json:"policyName,omitempty"`
+	// Represents the settings of a target tracking scaling policy that will be
+	// modified.
+	TargetTrackingScalingPolicyConfiguration *AutoScalingTargetTrackingScalingPolicyConfigurationUpdate `json:"targetTrackingScalingPolicyConfiguration,omitempty"`
+}
+
 // Represents the auto scaling settings for a global table or global secondary
 // index.
 type AutoScalingSettingsDescription struct {
-	AutoScalingDisabled *bool   `json:"autoScalingDisabled,omitempty"`
-	AutoScalingRoleARN  *string `json:"autoScalingRoleARN,omitempty"`
-	MaximumUnits        *int64  `json:"maximumUnits,omitempty"`
-	MinimumUnits        *int64  `json:"minimumUnits,omitempty"`
+	AutoScalingDisabled *bool                           `json:"autoScalingDisabled,omitempty"`
+	AutoScalingRoleARN  *string                         `json:"autoScalingRoleARN,omitempty"`
+	MaximumUnits        *int64                          `json:"maximumUnits,omitempty"`
+	MinimumUnits        *int64                          `json:"minimumUnits,omitempty"`
+	ScalingPolicies     []*AutoScalingPolicyDescription `json:"scalingPolicies,omitempty"`
 }
 
 // Represents the auto scaling settings to be modified for a global table or
@@ -56,17 +72,25 @@ type AutoScalingSettingsUpdate struct {
 	AutoScalingDisabled *bool  `json:"autoScalingDisabled,omitempty"`
 	MaximumUnits        *int64 `json:"maximumUnits,omitempty"`
 	MinimumUnits        *int64 `json:"minimumUnits,omitempty"`
+	// Represents the auto scaling policy to be modified.
+	ScalingPolicyUpdate *AutoScalingPolicyUpdate `json:"scalingPolicyUpdate,omitempty"`
 }
 
 // Represents the properties of a target tracking scaling policy.
 type AutoScalingTargetTrackingScalingPolicyConfigurationDescription struct {
-	DisableScaleIn *bool `json:"disableScaleIn,omitempty"`
+	DisableScaleIn   *bool    `json:"disableScaleIn,omitempty"`
+	ScaleInCooldown  *int64   `json:"scaleInCooldown,omitempty"`
+	ScaleOutCooldown *int64   `json:"scaleOutCooldown,omitempty"`
+	TargetValue      *float64 `json:"targetValue,omitempty"`
 }
 
 // Represents the settings of a target tracking scaling policy that will be
 // modified.
 type AutoScalingTargetTrackingScalingPolicyConfigurationUpdate struct {
-	DisableScaleIn *bool `json:"disableScaleIn,omitempty"`
+	DisableScaleIn   *bool    `json:"disableScaleIn,omitempty"`
+	ScaleInCooldown  *int64   `json:"scaleInCooldown,omitempty"`
+	ScaleOutCooldown *int64   `json:"scaleOutCooldown,omitempty"`
+	TargetValue      *float64 `json:"targetValue,omitempty"`
 }
 
 // Contains the description of the backup created for the table.
@@ -128,6 +152,11 @@ type BillingModeSummary struct {
 
 // Represents a request to perform a check that an item exists or to check the
 // condition of specific attributes of the item.
+type CSVOptions struct {
+	Delimiter  *string   `json:"delimiter,omitempty"`
+	HeaderList []*string `json:"headerList,omitempty"`
+}
+
 type ConditionCheck struct {
 	TableName *string `json:"tableName,omitempty"`
 }
@@ -142,6 +171,14 @@ type ConsumedCapacity struct {
 	TableName *string `json:"tableName,omitempty"`
 }
 
+// Represents the continuous backups and point in time recovery settings on
+// the table.
+type ContinuousBackupsDescription struct {
+	ContinuousBackupsStatus *string `json:"continuousBackupsStatus,omitempty"`
+	// The description of the point in time settings applied to the table.
+	PointInTimeRecoveryDescription *PointInTimeRecoveryDescription `json:"pointInTimeRecoveryDescription,omitempty"`
+}
+
 // Represents a Contributor Insights summary entry.
 type ContributorInsightsSummary struct {
 	IndexName *string `json:"indexName,omitempty"`
@@ -152,6 +189,10 @@ type ContributorInsightsSummary struct {
 type CreateGlobalSecondaryIndexAction struct {
 	IndexName *string             `json:"indexName,omitempty"`
 	KeySchema []*KeySchemaElement `json:"keySchema,omitempty"`
+	// Sets the maximum number of read and write units for the specified on-demand
+	// table. If you use this parameter, you must specify MaxReadRequestUnits, MaxWriteRequestUnits,
+	// or both.
+	OnDemandThroughput *OnDemandThroughput `json:"onDemandThroughput,omitempty"`
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
 	// attributes, which are automatically projected.
@@ -163,6 +204,11 @@ type CreateGlobalSecondaryIndexAction struct {
 	// Account, and Table Quotas (https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/Limits.html)
 	// in the Amazon DynamoDB Developer Guide.
 	ProvisionedThroughput *ProvisionedThroughput `json:"provisionedThroughput,omitempty"`
+	// Provides visibility into the number of read and write operations your table
+	// or secondary index can instantaneously support. The settings can be modified
+	// using the UpdateTable operation to meet the throughput requirements of an
+	// upcoming peak event.
+	WarmThroughput *WarmThroughput `json:"warmThroughput,omitempty"`
 }
 
 // Represents a replica to be added.
@@ -174,6 +220,10 @@ type CreateReplicaAction struct {
 type CreateReplicationGroupMemberAction struct {
 	GlobalSecondaryIndexes []*ReplicaGlobalSecondaryIndex `json:"globalSecondaryIndexes,omitempty"`
 	KMSMasterKeyID         *string                        `json:"kmsMasterKeyID,omitempty"`
+	// The maximum on-demand throughput settings for the specified replica table
+	// being created. You can only modify MaxReadRequestUnits, because you can't
+	// modify MaxWriteRequestUnits for individual replica tables.
+	OnDemandThroughputOverride *OnDemandThroughputOverride `json:"onDemandThroughputOverride,omitempty"`
 	// Replica-specific provisioned throughput settings. If not specified, uses
 	// the source table's provisioned throughput settings.
 	ProvisionedThroughputOverride *ProvisionedThroughputOverride `json:"provisionedThroughputOverride,omitempty"`
@@ -245,8 +295,13 @@ type Get struct {
 
 // Represents the properties of a global secondary index.
 type GlobalSecondaryIndex struct {
-	IndexName *string             `json:"indexName,omitempty"`
-	KeySchema []*KeySchemaElement `json:"keySchema,omitempty"`
+	ContributorInsights *string             `json:"contributorInsights,omitempty"`
+	IndexName           *string             `json:"indexName,omitempty"`
+	KeySchema           []*KeySchemaElement `json:"keySchema,omitempty"`
+	// Sets the maximum number of read and write units for the specified on-demand
+	// table. If you use this parameter, you must specify MaxReadRequestUnits, MaxWriteRequestUnits,
+	// or both.
+	OnDemandThroughput *OnDemandThroughput `json:"onDemandThroughput,omitempty"`
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
 	// attributes, which are automatically projected.
@@ -258,6 +313,11 @@ type GlobalSecondaryIndex struct {
 	// Account, and Table Quotas (https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/Limits.html)
 	// in the Amazon DynamoDB Developer Guide.
 	ProvisionedThroughput *ProvisionedThroughput `json:"provisionedThroughput,omitempty"`
+	// Provides visibility into the number of read and write operations your table
+	// or secondary index can instantaneously support. The settings can be modified
+	// using the UpdateTable operation to meet the throughput requirements of an
+	// upcoming peak event.
+	WarmThroughput *WarmThroughput `json:"warmThroughput,omitempty"`
 }
 
 // Represents the auto scaling settings of a global secondary index for a global
@@ -275,6 +335,10 @@ type GlobalSecondaryIndexDescription struct {
 	IndexStatus    *string             `json:"indexStatus,omitempty"`
 	ItemCount      *int64              `json:"itemCount,omitempty"`
 	KeySchema      []*KeySchemaElement `json:"keySchema,omitempty"`
+	// Sets the maximum number of read and write units for the specified on-demand
+	// table. If you use this parameter, you must specify MaxReadRequestUnits, MaxWriteRequestUnits,
+	// or both.
+	OnDemandThroughput *OnDemandThroughput `json:"onDemandThroughput,omitempty"`
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
 	// attributes, which are automatically projected.
@@ -282,6 +346,8 @@ type GlobalSecondaryIndexDescription struct {
 	// Represents the provisioned throughput settings for the table, consisting
 	// of read and write capacity units, along with data about increases and decreases.
 	ProvisionedThroughput *ProvisionedThroughputDescription `json:"provisionedThroughput,omitempty"`
+	// The description of the warm throughput value on a global secondary index.
+	WarmThroughput *GlobalSecondaryIndexWarmThroughputDescription `json:"warmThroughput,omitempty"`
 }
 
 // Represents the properties of a global secondary index for the table when
@@ -320,6 +386,13 @@ type GlobalSecondaryIndexUpdate struct {
 	Update *UpdateGlobalSecondaryIndexAction `json:"update,omitempty"`
 }
 
+// The description of the warm throughput value on a global secondary index.
+type GlobalSecondaryIndexWarmThroughputDescription struct {
+	ReadUnitsPerSecond  *int64  `json:"readUnitsPerSecond,omitempty"`
+	Status              *string `json:"status,omitempty"`
+	WriteUnitsPerSecond *int64  `json:"writeUnitsPerSecond,omitempty"`
+}
+
 // Contains details about the global table.
 type GlobalTableDescription struct {
 	CreationDateTime  *metav1.Time          `json:"creationDateTime,omitempty"`
@@ -343,15 +416,57 @@ type GlobalTable_SDK struct {
 }
 
 // Summary information about the source file for the import.
+// The S3 source location and format options for an import into the table.
+type ImportSourceSpecification struct {
+	InputCompressionType *string `json:"inputCompressionType,omitempty"`
+	InputFormat          *string `json:"inputFormat,omitempty"`
+	// The format options for the data that was imported into the target table.
+	// There is one value, CsvOption.
+	InputFormatOptions *InputFormatOptions `json:"inputFormatOptions,omitempty"`
+	// The S3 bucket that is being imported from. This includes the bucket name
+	// (required), key prefix (optional) and bucket account owner ID (optional).
+	S3BucketSource *S3BucketSource `json:"s3BucketSource,omitempty"`
+}
+
 type ImportSummary struct {
 	TableARN *string `json:"tableARN,omitempty"`
 }
 
 // Represents the properties of the table being imported into.
 type ImportTableDescription struct {
-	ProcessedSizeBytes *int64  `json:"processedSizeBytes,omitempty"`
-	TableARN           *string `json:"tableARN,omitempty"`
-	TableID            *string `json:"tableID,omitempty"`
+	EndTime              *metav1.Time `json:"endTime,omitempty"`
+	ErrorCount           *int64       `json:"errorCount,omitempty"`
+	FailureCode          *string      `json:"failureCode,omitempty"`
+	FailureMessage       *string      `json:"failureMessage,omitempty"`
+	ImportARN            *string      `json:"importARN,omitempty"`
+	ImportStatus         *string      `json:"importStatus,omitempty"`
+	ImportedItemCount    *int64       `json:"importedItemCount,omitempty"`
+	InputCompressionType *string      `json:"inputCompressionType,omitempty"`
+	InputFormat          *string      `json:"inputFormat,omitempty"`
+	// The format options for the data that was imported into the target table.
+	// There is one value, CsvOption.
+	InputFormatOptions *InputFormatOptions `json:"inputFormatOptions,omitempty"`
+	ProcessedItemCount *int64              `json:"processedItemCount,omitempty"`
+	ProcessedSizeBytes *int64              `json:"processedSizeBytes,omitempty"`
+	// The S3 bucket that is being imported from. This includes the bucket name
+	// (required), key prefix (optional) and bucket account owner ID (optional).
+	S3BucketSource *S3BucketSource `json:"s3BucketSource,omitempty"`
+	StartTime      *metav1.Time    `json:"startTime,omitempty"`
+	TableARN       *string         `json:"tableARN,omitempty"`
+	TableID        *string         `json:"tableID,omitempty"`
+}
+
+// Optional object containing the parameters specific to an incremental export.
+type IncrementalExportSpecification struct {
+	ExportFromTime *metav1.Time `json:"exportFromTime,omitempty"`
+	ExportToTime   *metav1.Time `json:"exportToTime,omitempty"`
+	ExportViewType *string      `json:"exportViewType,omitempty"`
+}
+
+// The format options for the data that was imported into the target table.
+// There is one value, CsvOption.
+type InputFormatOptions struct {
+	CSV *CSVOptions `json:"csv,omitempty"`
 }
 
 // Represents a single element of a key schema. A key schema specifies the attributes
@@ -372,8 +487,10 @@ type KeySchemaElement struct {
 
 // Describes a Kinesis data stream destination.
 type KinesisDataStreamDestination struct {
-	DestinationStatusDescription *string `json:"destinationStatusDescription,omitempty"`
-	StreamARN                    *string `json:"streamARN,omitempty"`
+	ApproximateCreationDateTimePrecision *string `json:"approximateCreationDateTimePrecision,omitempty"`
+	DestinationStatus                    *string `json:"destinationStatus,omitempty"`
+	DestinationStatusDescription         *string `json:"destinationStatusDescription,omitempty"`
+	StreamARN                            *string `json:"streamARN,omitempty"`
 }
 
 // Represents the properties of a local secondary index.
@@ -410,15 +527,33 @@ type LocalSecondaryIndexInfo struct {
 	Projection *Projection `json:"projection,omitempty"`
 }
 
+// Sets the maximum number of read and write units for the specified on-demand
+// table. If you use this parameter, you must specify MaxReadRequestUnits, MaxWriteRequestUnits,
+// or both.
+type OnDemandThroughput struct {
+	MaxReadRequestUnits  *int64 `json:"maxReadRequestUnits,omitempty"`
+	MaxWriteRequestUnits *int64 `json:"maxWriteRequestUnits,omitempty"`
+}
+
+// Overrides the on-demand throughput settings for this replica table. If you
+// don't specify a value for this parameter, it uses the source table's on-demand
+// throughput settings.
+type OnDemandThroughputOverride struct {
+	MaxReadRequestUnits *int64 `json:"maxReadRequestUnits,omitempty"`
+}
+
 // The description of the point in time settings applied to the table.
 type PointInTimeRecoveryDescription struct {
 	EarliestRestorableDateTime *metav1.Time `json:"earliestRestorableDateTime,omitempty"`
 	LatestRestorableDateTime   *metav1.Time `json:"latestRestorableDateTime,omitempty"`
+	PointInTimeRecoveryStatus  *string      `json:"pointInTimeRecoveryStatus,omitempty"`
+	RecoveryPeriodInDays       *int64       `json:"recoveryPeriodInDays,omitempty"`
 }
 
 // Represents the settings used to enable point in time recovery.
 type PointInTimeRecoverySpecification struct {
-	PointInTimeRecoveryEnabled *bool `json:"pointInTimeRecoveryEnabled,omitempty"`
+	PointInTimeRecoveryEnabled *bool  `json:"pointInTimeRecoveryEnabled,omitempty"`
+	RecoveryPeriodInDays       *int64 `json:"recoveryPeriodInDays,omitempty"`
 }
 
 // Represents attributes that are copied (projected) from the table into an
@@ -468,19 +603,33 @@ type Replica struct {
 
 // Represents the auto scaling settings of the replica.
 type ReplicaAutoScalingDescription struct {
-	RegionName    *string `json:"regionName,omitempty"`
-	ReplicaStatus *string `json:"replicaStatus,omitempty"`
+	GlobalSecondaryIndexes []*ReplicaGlobalSecondaryIndexAutoScalingDescription `json:"globalSecondaryIndexes,omitempty"`
+	RegionName             *string                                              `json:"regionName,omitempty"`
+	// Represents the auto scaling settings for a global table or global secondary
+	// index.
+	ReplicaProvisionedReadCapacityAutoScalingSettings *AutoScalingSettingsDescription `json:"replicaProvisionedReadCapacityAutoScalingSettings,omitempty"`
+	// Represents the auto scaling settings for a global table or global secondary
+	// index.
+	ReplicaProvisionedWriteCapacityAutoScalingSettings *AutoScalingSettingsDescription `json:"replicaProvisionedWriteCapacityAutoScalingSettings,omitempty"`
+	ReplicaStatus                                      *string                         `json:"replicaStatus,omitempty"`
 }
 
 // Represents the auto scaling settings of a replica that will be modified.
 type ReplicaAutoScalingUpdate struct {
-	RegionName *string `json:"regionName,omitempty"`
+	RegionName                         *string                                         `json:"regionName,omitempty"`
+	ReplicaGlobalSecondaryIndexUpdates []*ReplicaGlobalSecondaryIndexAutoScalingUpdate `json:"replicaGlobalSecondaryIndexUpdates,omitempty"`
+	// Represents the auto scaling settings to be modified for a global table or
+	// global secondary index.
+	ReplicaProvisionedReadCapacityAutoScalingUpdate *AutoScalingSettingsUpdate `json:"replicaProvisionedReadCapacityAutoScalingUpdate,omitempty"`
 }
 
 // Contains the details of the replica.
 type ReplicaDescription struct {
 	GlobalSecondaryIndexes []*ReplicaGlobalSecondaryIndexDescription `json:"globalSecondaryIndexes,omitempty"`
 	KMSMasterKeyID         *string                                   `json:"kmsMasterKeyID,omitempty"`
+	// Overrides the maximum on-demand throughput settings for the specified
+	// replica table.
+	OnDemandThroughputOverride *OnDemandThroughputOverride `json:"onDemandThroughputOverride,omitempty"`
 	// Replica-specific provisioned throughput settings. If not specified, uses
 	// the source table's provisioned throughput settings.
 	ProvisionedThroughputOverride *ProvisionedThroughputOverride `json:"provisionedThroughputOverride,omitempty"`
@@ -496,6 +645,9 @@ type ReplicaDescription struct {
 // Represents the properties of a replica global secondary index.
 type ReplicaGlobalSecondaryIndex struct {
 	IndexName *string `json:"indexName,omitempty"`
+	// Overrides the maximum on-demand throughput settings for the specified
+	// global secondary index in the specified replica table.
+	OnDemandThroughputOverride *OnDemandThroughputOverride `json:"onDemandThroughputOverride,omitempty"`
 	// Replica-specific provisioned throughput settings. If not specified, uses
 	// the source table's provisioned throughput settings.
 	ProvisionedThroughputOverride *ProvisionedThroughputOverride `json:"provisionedThroughputOverride,omitempty"`
@@ -506,17 +658,29 @@ type ReplicaGlobalSecondaryIndex struct {
 type ReplicaGlobalSecondaryIndexAutoScalingDescription struct {
 	IndexName   *string `json:"indexName,omitempty"`
 	IndexStatus *string `json:"indexStatus,omitempty"`
+	// Represents the auto scaling settings for a global table or global secondary
+	// index.
+	ProvisionedReadCapacityAutoScalingSettings *AutoScalingSettingsDescription `json:"provisionedReadCapacityAutoScalingSettings,omitempty"`
+	// Represents the auto scaling settings for a global table or global secondary
+	// index.
+	ProvisionedWriteCapacityAutoScalingSettings *AutoScalingSettingsDescription `json:"provisionedWriteCapacityAutoScalingSettings,omitempty"`
 }
 
 // Represents the auto scaling settings of a global secondary index for a replica
 // that will be modified.
 type ReplicaGlobalSecondaryIndexAutoScalingUpdate struct {
 	IndexName *string `json:"indexName,omitempty"`
+	// Represents the auto scaling settings to be modified for a global table or
+	// global secondary index.
+	ProvisionedReadCapacityAutoScalingUpdate *AutoScalingSettingsUpdate `json:"provisionedReadCapacityAutoScalingUpdate,omitempty"`
 }
 
 // Represents the properties of a replica global secondary index.
 type ReplicaGlobalSecondaryIndexDescription struct {
 	IndexName *string `json:"indexName,omitempty"`
+	// Overrides the maximum on-demand throughput for the specified global
+	// secondary index in the specified replica table.
+	OnDemandThroughputOverride *OnDemandThroughputOverride `json:"onDemandThroughputOverride,omitempty"`
 	// Replica-specific provisioned throughput settings. If not specified, uses
 	// the source table's provisioned throughput settings.
 	ProvisionedThroughputOverride *ProvisionedThroughputOverride `json:"provisionedThroughputOverride,omitempty"`
@@ -600,6 +764,22 @@ type RestoreSummary struct {
 	SourceTableARN    *string      `json:"sourceTableARN,omitempty"`
 }
 
+// Identifies the source table and the point in time to restore it to.
+type RestoreTableToPointInTimeSpecification struct {
+	RestoreDateTime         *metav1.Time `json:"restoreDateTime,omitempty"`
+	SourceTableARN          *string      `json:"sourceTableARN,omitempty"`
+	SourceTableName         *string      `json:"sourceTableName,omitempty"`
+	UseLatestRestorableTime *bool        `json:"useLatestRestorableTime,omitempty"`
+}
+
+// The S3 bucket that is being imported from. This includes the bucket name
+// (required), key prefix (optional) and bucket account owner ID (optional).
+type S3BucketSource struct {
+	S3Bucket      *string `json:"s3Bucket,omitempty"`
+	S3BucketOwner *string `json:"s3BucketOwner,omitempty"`
+	S3KeyPrefix   *string `json:"s3KeyPrefix,omitempty"`
+}
+
 // The description of the server-side encryption status on the specified table.
 type SSEDescription struct {
 	InaccessibleEncryptionDateTime *metav1.Time `json:"inaccessibleEncryptionDateTime,omitempty"`
@@ -699,6 +879,10 @@ type TableDescription struct {
 	LatestStreamARN           *string                            `json:"latestStreamARN,omitempty"`
 	LatestStreamLabel         *string                            `json:"latestStreamLabel,omitempty"`
 	LocalSecondaryIndexes     []*LocalSecondaryIndexDescription  `json:"localSecondaryIndexes,omitempty"`
+	// Sets the maximum number of read and write units for the specified on-demand
+	// table. If you use this parameter, you must specify MaxReadRequestUnits, MaxWriteRequestUnits,
+	// or both.
+	OnDemandThroughput *OnDemandThroughput `json:"onDemandThroughput,omitempty"`
 	// Represents the provisioned throughput settings for the table, consisting
 	// of read and write capacity units, along with data about increases and decreases.
 	ProvisionedThroughput *ProvisionedThroughputDescription `json:"provisionedThroughput,omitempty"`
@@ -714,6 +898,14 @@ type TableDescription struct {
 	TableStatus         *string              `json:"tableStatus,omitempty"`
 }
 
+// Represents the warm throughput value (in read units per second and write
+// units per second) of the base table.
+type TableWarmThroughputDescription struct {
+	ReadUnitsPerSecond  *int64  `json:"readUnitsPerSecond,omitempty"`
+	Status              *string `json:"status,omitempty"`
+	WriteUnitsPerSecond *int64  `json:"writeUnitsPerSecond,omitempty"`
+}
+
 // Describes a tag. A tag is a key-value pair. You can add up to 50 tags to
 // a single DynamoDB table.
 //
@@ -752,6 +944,10 @@ type Update struct {
 // secondary index.
 type UpdateGlobalSecondaryIndexAction struct {
 	IndexName *string `json:"indexName,omitempty"`
+	// Sets the maximum number of read and write units for the specified on-demand
+	// table. If you use this parameter, you must specify MaxReadRequestUnits, MaxWriteRequestUnits,
+	// or both.
+	OnDemandThroughput *OnDemandThroughput `json:"onDemandThroughput,omitempty"`
 	// Represents the provisioned throughput settings for a specified table or index.
 	// The settings can be modified using the UpdateTable operation.
 	//
@@ -759,15 +955,31 @@ type UpdateGlobalSecondaryIndexAction struct {
 	// Account, and Table Quotas (https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/Limits.html)
 	// in the Amazon DynamoDB Developer Guide.
 	ProvisionedThroughput *ProvisionedThroughput `json:"provisionedThroughput,omitempty"`
+	// Provides visibility into the number of read and write operations your table
+	// or secondary index can instantaneously support. The settings can be modified
+	// using the UpdateTable operation to meet the throughput requirements of an
+	// upcoming peak event.
+	WarmThroughput *WarmThroughput `json:"warmThroughput,omitempty"`
 }
 
 // Represents a replica to be modified.
 type UpdateReplicationGroupMemberAction struct {
 	GlobalSecondaryIndexes []*ReplicaGlobalSecondaryIndex `json:"globalSecondaryIndexes,omitempty"`
 	KMSMasterKeyID         *string                        `json:"kmsMasterKeyID,omitempty"`
+	// Overrides the maximum on-demand throughput for the replica table.
+	OnDemandThroughputOverride *OnDemandThroughputOverride `json:"onDemandThroughputOverride,omitempty"`
 	// Replica-specific provisioned throughput settings. If not specified, uses
 	// the source table's provisioned throughput settings.
 	ProvisionedThroughputOverride *ProvisionedThroughputOverride `json:"provisionedThroughputOverride,omitempty"`
 	RegionName                    *string                        `json:"regionName,omitempty"`
 	TableClassOverride            *string                        `json:"tableClassOverride,omitempty"`
 }
+
+// Provides visibility into the number of read and write operations your table
+// or secondary index can instantaneously support. The settings can be modified
+// using the UpdateTable operation to meet the throughput requirements of an
+// upcoming peak event.
+type WarmThroughput struct {
+	ReadUnitsPerSecond  *int64 `json:"readUnitsPerSecond,omitempty"`
+	WriteUnitsPerSecond *int64 `json:"writeUnitsPerSecond,omitempty"`
+}
@@ -78,6 +78,56 @@ func (in *AttributeDefinition) DeepCopy() *AttributeDefinition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingPolicyDescription) DeepCopyInto(out *AutoScalingPolicyDescription) {
+	*out = *in
+	if in.PolicyName != nil {
+		in, out := &in.PolicyName, &out.PolicyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetTrackingScalingPolicyConfiguration != nil {
+		in, out := &in.TargetTrackingScalingPolicyConfiguration, &out.TargetTrackingScalingPolicyConfiguration
+		*out = new(AutoScalingTargetTrackingScalingPolicyConfigurationDescription)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingPolicyDescription.
+func (in *AutoScalingPolicyDescription) DeepCopy() *AutoScalingPolicyDescription {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingPolicyDescription)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingPolicyUpdate) DeepCopyInto(out *AutoScalingPolicyUpdate) {
+	*out = *in
+	if in.PolicyName != nil {
+		in, out := &in.PolicyName, &out.PolicyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetTrackingScalingPolicyConfiguration != nil {
+		in, out := &in.TargetTrackingScalingPolicyConfiguration, &out.TargetTrackingScalingPolicyConfiguration
+		*out = new(AutoScalingTargetTrackingScalingPolicyConfigurationUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingPolicyUpdate.
+func (in *AutoScalingPolicyUpdate) DeepCopy() *AutoScalingPolicyUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingPolicyUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutoScalingSettingsDescription) DeepCopyInto(out *AutoScalingSettingsDescription) {
 	*out = *in
@@ -101,6 +151,17 @@ func (in *AutoScalingSettingsDescription) DeepCopyInto(out *AutoScalingSettingsD
 		*out = new(int64)
 		**out = **in
 	}
+	if in.ScalingPolicies != nil {
+		in, out := &in.ScalingPolicies, &out.ScalingPolicies
+		*out = make([]*AutoScalingPolicyDescription, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AutoScalingPolicyDescription)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingSettingsDescription.
@@ -131,6 +192,11 @@ func (in *AutoScalingSettingsUpdate) DeepCopyInto(out *AutoScalingSettingsUpdate
 		*out = new(int64)
 		**out = **in
 	}
+	if in.ScalingPolicyUpdate != nil {
+		in, out := &in.ScalingPolicyUpdate, &out.ScalingPolicyUpdate
+		*out = new(AutoScalingPolicyUpdate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingSettingsUpdate.
@@ -151,6 +217,21 @@ func (in *AutoScalingTargetTrackingScalingPolicyConfigurationDescription) DeepCo
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ScaleInCooldown != nil {
+		in, out := &in.ScaleInCooldown, &out.ScaleInCooldown
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ScaleOutCooldown != nil {
+		in, out := &in.ScaleOutCooldown, &out.ScaleOutCooldown
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TargetValue != nil {
+		in, out := &in.TargetValue, &out.TargetValue
+		*out = new(float64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingTargetTrackingScalingPolicyConfigurationDescription.
@@ -171,6 +252,21 @@ func (in *AutoScalingTargetTrackingScalingPolicyConfigurationUpdate) DeepCopyInt
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ScaleInCooldown != nil {
+		in, out := &in.ScaleInCooldown, &out.ScaleInCooldown
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ScaleOutCooldown != nil {
+		in, out := &in.ScaleOutCooldown, &out.ScaleOutCooldown
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TargetValue != nil {
+		in, out := &in.TargetValue, &out.TargetValue
+		*out = new(float64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingTargetTrackingScalingPolicyConfigurationUpdate.
@@ -333,6 +429,22 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.TableRef != nil {
+		in, out := &in.TableRef, &out.TableRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSpec.
@@ -387,6 +499,16 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SourceTableDetails != nil {
+		in, out := &in.SourceTableDetails, &out.SourceTableDetails
+		*out = new(SourceTableDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceTableFeatureDetails != nil {
+		in, out := &in.SourceTableFeatureDetails, &out.SourceTableFeatureDetails
+		*out = new(SourceTableFeatureDetails)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStatus.
@@ -526,6 +648,37 @@ func (in *BillingModeSummary) DeepCopy() *BillingModeSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSVOptions) DeepCopyInto(out *CSVOptions) {
+	*out = *in
+	if in.Delimiter != nil {
+		in, out := &in.Delimiter, &out.Delimiter
+		*out = new(string)
+		**out = **in
+	}
+	if in.HeaderList != nil {
+		in, out := &in.HeaderList, &out.HeaderList
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSVOptions.
+func (in *CSVOptions) DeepCopy() *CSVOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CSVOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionCheck) DeepCopyInto(out *ConditionCheck) {
 	*out = *in
@@ -566,6 +719,31 @@ func (in *ConsumedCapacity) DeepCopy() *ConsumedCapacity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContinuousBackupsDescription) DeepCopyInto(out *ContinuousBackupsDescription) {
+	*out = *in
+	if in.ContinuousBackupsStatus != nil {
+		in, out := &in.ContinuousBackupsStatus, &out.ContinuousBackupsStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.PointInTimeRecoveryDescription != nil {
+		in, out := &in.PointInTimeRecoveryDescription, &out.PointInTimeRecoveryDescription
+		*out = new(PointInTimeRecoveryDescription)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContinuousBackupsDescription.
+func (in *ContinuousBackupsDescription) DeepCopy() *ContinuousBackupsDescription {
+	if in == nil {
+		return nil
+	}
+	out := new(ContinuousBackupsDescription)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContributorInsightsSummary) DeepCopyInto(out *ContributorInsightsSummary) {
 	*out = *in
@@ -610,6 +788,11 @@ func (in *CreateGlobalSecondaryIndexAction) DeepCopyInto(out *CreateGlobalSecond
 			}
 		}
 	}
+	if in.OnDemandThroughput != nil {
+		in, out := &in.OnDemandThroughput, &out.OnDemandThroughput
+		*out = new(OnDemandThroughput)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Projection != nil {
 		in, out := &in.Projection, &out.Projection
 		*out = new(Projection)
@@ -620,6 +803,11 @@ func (in *CreateGlobalSecondaryIndexAction) DeepCopyInto(out *CreateGlobalSecond
 		*out = new(ProvisionedThroughput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WarmThroughput != nil {
+		in, out := &in.WarmThroughput, &out.WarmThroughput
+		*out = new(WarmThroughput)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreateGlobalSecondaryIndexAction.
@@ -671,6 +859,11 @@ func (in *CreateReplicationGroupMemberAction) DeepCopyInto(out *CreateReplicatio
 		*out = new(string)
 		**out = **in
 	}
+	if in.OnDemandThroughputOverride != nil {
+		in, out := &in.OnDemandThroughputOverride, &out.OnDemandThroughputOverride
+		*out = new(OnDemandThroughputOverride)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProvisionedThroughputOverride != nil {
 		in, out := &in.ProvisionedThroughputOverride, &out.ProvisionedThroughputOverride
 		*out = new(ProvisionedThroughputOverride)
@@ -818,6 +1011,33 @@ func (in *ExpectedAttributeValue) DeepCopy() *ExpectedAttributeValue {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Export) DeepCopyInto(out *Export) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Export.
+func (in *Export) DeepCopy() *Export {
+	if in == nil {
+		return nil
+	}
+	out := new(Export)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Export) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExportDescription) DeepCopyInto(out *ExportDescription) {
 	*out = *in
@@ -848,6 +1068,176 @@ func (in *ExportDescription) DeepCopy() *ExportDescription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportList) DeepCopyInto(out *ExportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Export, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportList.
+func (in *ExportList) DeepCopy() *ExportList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportSpec) DeepCopyInto(out *ExportSpec) {
+	*out = *in
+	if in.ExportFormat != nil {
+		in, out := &in.ExportFormat, &out.ExportFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExportTime != nil {
+		in, out := &in.ExportTime, &out.ExportTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExportType != nil {
+		in, out := &in.ExportType, &out.ExportType
+		*out = new(string)
+		**out = **in
+	}
+	if in.IncrementalExportSpecification != nil {
+		in, out := &in.IncrementalExportSpecification, &out.IncrementalExportSpecification
+		*out = new(IncrementalExportSpecification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3Bucket != nil {
+		in, out := &in.S3Bucket, &out.S3Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3BucketOwner != nil {
+		in, out := &in.S3BucketOwner, &out.S3BucketOwner
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3Prefix != nil {
+		in, out := &in.S3Prefix, &out.S3Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3SSEAlgorithm != nil {
+		in, out := &in.S3SSEAlgorithm, &out.S3SSEAlgorithm
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3SSEKMSKeyID != nil {
+		in, out := &in.S3SSEKMSKeyID, &out.S3SSEKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TableARN != nil {
+		in, out := &in.TableARN, &out.TableARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.TableRef != nil {
+		in, out := &in.TableRef, &out.TableRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportSpec.
+func (in *ExportSpec) DeepCopy() *ExportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportStatus) DeepCopyInto(out *ExportStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.BilledSizeBytes != nil {
+		in, out := &in.BilledSizeBytes, &out.BilledSizeBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExportManifest != nil {
+		in, out := &in.ExportManifest, &out.ExportManifest
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExportStatus != nil {
+		in, out := &in.ExportStatus, &out.ExportStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureCode != nil {
+		in, out := &in.FailureCode, &out.FailureCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.ItemCount != nil {
+		in, out := &in.ItemCount, &out.ItemCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportStatus.
+func (in *ExportStatus) DeepCopy() *ExportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Get) DeepCopyInto(out *Get) {
 	*out = *in
@@ -871,6 +1261,11 @@ func (in *Get) DeepCopy() *Get {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalSecondaryIndex) DeepCopyInto(out *GlobalSecondaryIndex) {
 	*out = *in
+	if in.ContributorInsights != nil {
+		in, out := &in.ContributorInsights, &out.ContributorInsights
+		*out = new(string)
+		**out = **in
+	}
 	if in.IndexName != nil {
 		in, out := &in.IndexName, &out.IndexName
 		*out = new(string)
@@ -887,6 +1282,11 @@ func (in *GlobalSecondaryIndex) DeepCopyInto(out *GlobalSecondaryIndex) {
 			}
 		}
 	}
+	if in.OnDemandThroughput != nil {
+		in, out := &in.OnDemandThroughput, &out.OnDemandThroughput
+		*out = new(OnDemandThroughput)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Projection != nil {
 		in, out := &in.Projection, &out.Projection
 		*out = new(Projection)
@@ -897,6 +1297,11 @@ func (in *GlobalSecondaryIndex) DeepCopyInto(out *GlobalSecondaryIndex) {
 		*out = new(ProvisionedThroughput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WarmThroughput != nil {
+		in, out := &in.WarmThroughput, &out.WarmThroughput
+		*out = new(WarmThroughput)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalSecondaryIndex.
@@ -973,6 +1378,11 @@ func (in *GlobalSecondaryIndexDescription) DeepCopyInto(out *GlobalSecondaryInde
 			}
 		}
 	}
+	if in.OnDemandThroughput != nil {
+		in, out := &in.OnDemandThroughput, &out.OnDemandThroughput
+		*out = new(OnDemandThroughput)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Projection != nil {
 		in, out := &in.Projection, &out.Projection
 		*out = new(Projection)
@@ -983,6 +1393,11 @@ func (in *GlobalSecondaryIndexDescription) DeepCopyInto(out *GlobalSecondaryInde
 		*out = new(ProvisionedThroughputDescription)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WarmThroughput != nil {
+		in, out := &in.WarmThroughput, &out.WarmThroughput
+		*out = new(GlobalSecondaryIndexWarmThroughputDescription)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalSecondaryIndexDescription.
@@ -1066,6 +1481,36 @@ func (in *GlobalSecondaryIndexUpdate) DeepCopy() *GlobalSecondaryIndexUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalSecondaryIndexWarmThroughputDescription) DeepCopyInto(out *GlobalSecondaryIndexWarmThroughputDescription) {
+	*out = *in
+	if in.ReadUnitsPerSecond != nil {
+		in, out := &in.ReadUnitsPerSecond, &out.ReadUnitsPerSecond
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.WriteUnitsPerSecond != nil {
+		in, out := &in.WriteUnitsPerSecond, &out.WriteUnitsPerSecond
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalSecondaryIndexWarmThroughputDescription.
+func (in *GlobalSecondaryIndexWarmThroughputDescription) DeepCopy() *GlobalSecondaryIndexWarmThroughputDescription {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalSecondaryIndexWarmThroughputDescription)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalTable) DeepCopyInto(out *GlobalTable) {
 	*out = *in
@@ -1254,6 +1699,17 @@ func (in *GlobalTableStatus) DeepCopyInto(out *GlobalTableStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ReplicationGroupDescriptions != nil {
+		in, out := &in.ReplicationGroupDescriptions, &out.ReplicationGroupDescriptions
+		*out = make([]*ReplicaDescription, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ReplicaDescription)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalTableStatus.
@@ -1297,6 +1753,41 @@ func (in *GlobalTable_SDK) DeepCopy() *GlobalTable_SDK {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportSourceSpecification) DeepCopyInto(out *ImportSourceSpecification) {
+	*out = *in
+	if in.InputCompressionType != nil {
+		in, out := &in.InputCompressionType, &out.InputCompressionType
+		*out = new(string)
+		**out = **in
+	}
+	if in.InputFormat != nil {
+		in, out := &in.InputFormat, &out.InputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.InputFormatOptions != nil {
+		in, out := &in.InputFormatOptions, &out.InputFormatOptions
+		*out = new(InputFormatOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3BucketSource != nil {
+		in, out := &in.S3BucketSource, &out.S3BucketSource
+		*out = new(S3BucketSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportSourceSpecification.
+func (in *ImportSourceSpecification) DeepCopy() *ImportSourceSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportSourceSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImportSummary) DeepCopyInto(out *ImportSummary) {
 	*out = *in
@@ -1320,11 +1811,74 @@ func (in *ImportSummary) DeepCopy() *ImportSummary {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImportTableDescription) DeepCopyInto(out *ImportTableDescription) {
 	*out = *in
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ErrorCount != nil {
+		in, out := &in.ErrorCount, &out.ErrorCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FailureCode != nil {
+		in, out := &in.FailureCode, &out.FailureCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImportARN != nil {
+		in, out := &in.ImportARN, &out.ImportARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImportStatus != nil {
+		in, out := &in.ImportStatus, &out.ImportStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImportedItemCount != nil {
+		in, out := &in.ImportedItemCount, &out.ImportedItemCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.InputCompressionType != nil {
+		in, out := &in.InputCompressionType, &out.InputCompressionType
+		*out = new(string)
+		**out = **in
+	}
+	if in.InputFormat != nil {
+		in, out := &in.InputFormat, &out.InputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.InputFormatOptions != nil {
+		in, out := &in.InputFormatOptions, &out.InputFormatOptions
+		*out = new(InputFormatOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProcessedItemCount != nil {
+		in, out := &in.ProcessedItemCount, &out.ProcessedItemCount
+		*out = new(int64)
+		**out = **in
+	}
 	if in.ProcessedSizeBytes != nil {
 		in, out := &in.ProcessedSizeBytes, &out.ProcessedSizeBytes
 		*out = new(int64)
 		**out = **in
 	}
+	if in.S3BucketSource != nil {
+		in, out := &in.S3BucketSource, &out.S3BucketSource
+		*out = new(S3BucketSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
 	if in.TableARN != nil {
 		in, out := &in.TableARN, &out.TableARN
 		*out = new(string)
@@ -1342,7 +1896,55 @@ func (in *ImportTableDescription) DeepCopy() *ImportTableDescription {
 	if in == nil {
 		return nil
 	}
-	out := new(ImportTableDescription)
+	out := new(ImportTableDescription)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IncrementalExportSpecification) DeepCopyInto(out *IncrementalExportSpecification) {
+	*out = *in
+	if in.ExportFromTime != nil {
+		in, out := &in.ExportFromTime, &out.ExportFromTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExportToTime != nil {
+		in, out := &in.ExportToTime, &out.ExportToTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExportViewType != nil {
+		in, out := &in.ExportViewType, &out.ExportViewType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncrementalExportSpecification.
+func (in *IncrementalExportSpecification) DeepCopy() *IncrementalExportSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(IncrementalExportSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InputFormatOptions) DeepCopyInto(out *InputFormatOptions) {
+	*out = *in
+	if in.CSV != nil {
+		in, out := &in.CSV, &out.CSV
+		*out = new(CSVOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InputFormatOptions.
+func (in *InputFormatOptions) DeepCopy() *InputFormatOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(InputFormatOptions)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1375,6 +1977,16 @@ func (in *KeySchemaElement) DeepCopy() *KeySchemaElement {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KinesisDataStreamDestination) DeepCopyInto(out *KinesisDataStreamDestination) {
 	*out = *in
+	if in.ApproximateCreationDateTimePrecision != nil {
+		in, out := &in.ApproximateCreationDateTimePrecision, &out.ApproximateCreationDateTimePrecision
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationStatus != nil {
+		in, out := &in.DestinationStatus, &out.DestinationStatus
+		*out = new(string)
+		**out = **in
+	}
 	if in.DestinationStatusDescription != nil {
 		in, out := &in.DestinationStatusDescription, &out.DestinationStatusDescription
 		*out = new(string)
@@ -1520,6 +2132,51 @@ func (in *LocalSecondaryIndexInfo) DeepCopy() *LocalSecondaryIndexInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnDemandThroughput) DeepCopyInto(out *OnDemandThroughput) {
+	*out = *in
+	if in.MaxReadRequestUnits != nil {
+		in, out := &in.MaxReadRequestUnits, &out.MaxReadRequestUnits
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxWriteRequestUnits != nil {
+		in, out := &in.MaxWriteRequestUnits, &out.MaxWriteRequestUnits
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnDemandThroughput.
+func (in *OnDemandThroughput) DeepCopy() *OnDemandThroughput {
+	if in == nil {
+		return nil
+	}
+	out := new(OnDemandThroughput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnDemandThroughputOverride) DeepCopyInto(out *OnDemandThroughputOverride) {
+	*out = *in
+	if in.MaxReadRequestUnits != nil {
+		in, out := &in.MaxReadRequestUnits, &out.MaxReadRequestUnits
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnDemandThroughputOverride.
+func (in *OnDemandThroughputOverride) DeepCopy() *OnDemandThroughputOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(OnDemandThroughputOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PointInTimeRecoveryDescription) DeepCopyInto(out *PointInTimeRecoveryDescription) {
 	*out = *in
@@ -1531,6 +2188,16 @@ func (in *PointInTimeRecoveryDescription) DeepCopyInto(out *PointInTimeRecoveryD
 		in, out := &in.LatestRestorableDateTime, &out.LatestRestorableDateTime
 		*out = (*in).DeepCopy()
 	}
+	if in.PointInTimeRecoveryStatus != nil {
+		in, out := &in.PointInTimeRecoveryStatus, &out.PointInTimeRecoveryStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.RecoveryPeriodInDays != nil {
+		in, out := &in.RecoveryPeriodInDays, &out.RecoveryPeriodInDays
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PointInTimeRecoveryDescription.
@@ -1551,6 +2218,11 @@ func (in *PointInTimeRecoverySpecification) DeepCopyInto(out *PointInTimeRecover
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RecoveryPeriodInDays != nil {
+		in, out := &in.RecoveryPeriodInDays, &out.RecoveryPeriodInDays
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PointInTimeRecoverySpecification.
@@ -1720,11 +2392,32 @@ func (in *Replica) DeepCopy() *Replica {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicaAutoScalingDescription) DeepCopyInto(out *ReplicaAutoScalingDescription) {
 	*out = *in
+	if in.GlobalSecondaryIndexes != nil {
+		in, out := &in.GlobalSecondaryIndexes, &out.GlobalSecondaryIndexes
+		*out = make([]*ReplicaGlobalSecondaryIndexAutoScalingDescription, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ReplicaGlobalSecondaryIndexAutoScalingDescription)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.RegionName != nil {
 		in, out := &in.RegionName, &out.RegionName
 		*out = new(string)
 		**out = **in
 	}
+	if in.ReplicaProvisionedReadCapacityAutoScalingSettings != nil {
+		in, out := &in.ReplicaProvisionedReadCapacityAutoScalingSettings, &out.ReplicaProvisionedReadCapacityAutoScalingSettings
+		*out = new(AutoScalingSettingsDescription)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicaProvisionedWriteCapacityAutoScalingSettings != nil {
+		in, out := &in.ReplicaProvisionedWriteCapacityAutoScalingSettings, &out.ReplicaProvisionedWriteCapacityAutoScalingSettings
+		*out = new(AutoScalingSettingsDescription)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ReplicaStatus != nil {
 		in, out := &in.ReplicaStatus, &out.ReplicaStatus
 		*out = new(string)
@@ -1750,6 +2443,22 @@ func (in *ReplicaAutoScalingUpdate) DeepCopyInto(out *ReplicaAutoScalingUpdate)
 		*out = new(string)
 		**out = **in
 	}
+	if in.ReplicaGlobalSecondaryIndexUpdates != nil {
+		in, out := &in.ReplicaGlobalSecondaryIndexUpdates, &out.ReplicaGlobalSecondaryIndexUpdates
+		*out = make([]*ReplicaGlobalSecondaryIndexAutoScalingUpdate, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ReplicaGlobalSecondaryIndexAutoScalingUpdate)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ReplicaProvisionedReadCapacityAutoScalingUpdate != nil {
+		in, out := &in.ReplicaProvisionedReadCapacityAutoScalingUpdate, &out.ReplicaProvisionedReadCapacityAutoScalingUpdate
+		*out = new(AutoScalingSettingsUpdate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaAutoScalingUpdate.
@@ -1781,6 +2490,11 @@ func (in *ReplicaDescription) DeepCopyInto(out *ReplicaDescription) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.OnDemandThroughputOverride != nil {
+		in, out := &in.OnDemandThroughputOverride, &out.OnDemandThroughputOverride
+		*out = new(OnDemandThroughputOverride)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProvisionedThroughputOverride != nil {
 		in, out := &in.ProvisionedThroughputOverride, &out.ProvisionedThroughputOverride
 		*out = new(ProvisionedThroughputOverride)
@@ -1835,6 +2549,11 @@ func (in *ReplicaGlobalSecondaryIndex) DeepCopyInto(out *ReplicaGlobalSecondaryI
 		*out = new(string)
 		**out = **in
 	}
+	if in.OnDemandThroughputOverride != nil {
+		in, out := &in.OnDemandThroughputOverride, &out.OnDemandThroughputOverride
+		*out = new(OnDemandThroughputOverride)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProvisionedThroughputOverride != nil {
 		in, out := &in.ProvisionedThroughputOverride, &out.ProvisionedThroughputOverride
 		*out = new(ProvisionedThroughputOverride)
@@ -1865,6 +2584,16 @@ func (in *ReplicaGlobalSecondaryIndexAutoScalingDescription) DeepCopyInto(out *R
 		*out = new(string)
 		**out = **in
 	}
+	if in.ProvisionedReadCapacityAutoScalingSettings != nil {
+		in, out := &in.ProvisionedReadCapacityAutoScalingSettings, &out.ProvisionedReadCapacityAutoScalingSettings
+		*out = new(AutoScalingSettingsDescription)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProvisionedWriteCapacityAutoScalingSettings != nil {
+		in, out := &in.ProvisionedWriteCapacityAutoScalingSettings, &out.ProvisionedWriteCapacityAutoScalingSettings
+		*out = new(AutoScalingSettingsDescription)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaGlobalSecondaryIndexAutoScalingDescription.
@@ -1885,6 +2614,11 @@ func (in *ReplicaGlobalSecondaryIndexAutoScalingUpdate) DeepCopyInto(out *Replic
 		*out = new(string)
 		**out = **in
 	}
+	if in.ProvisionedReadCapacityAutoScalingUpdate != nil {
+		in, out := &in.ProvisionedReadCapacityAutoScalingUpdate, &out.ProvisionedReadCapacityAutoScalingUpdate
+		*out = new(AutoScalingSettingsUpdate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaGlobalSecondaryIndexAutoScalingUpdate.
@@ -1905,6 +2639,11 @@ func (in *ReplicaGlobalSecondaryIndexDescription) DeepCopyInto(out *ReplicaGloba
 		*out = new(string)
 		**out = **in
 	}
+	if in.OnDemandThroughputOverride != nil {
+		in, out := &in.OnDemandThroughputOverride, &out.OnDemandThroughputOverride
+		*out = new(OnDemandThroughputOverride)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProvisionedThroughputOverride != nil {
 		in, out := &in.ProvisionedThroughputOverride, &out.ProvisionedThroughputOverride
 		*out = new(ProvisionedThroughputOverride)
@@ -2141,6 +2880,70 @@ func (in *RestoreSummary) DeepCopy() *RestoreSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreTableToPointInTimeSpecification) DeepCopyInto(out *RestoreTableToPointInTimeSpecification) {
+	*out = *in
+	if in.RestoreDateTime != nil {
+		in, out := &in.RestoreDateTime, &out.RestoreDateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SourceTableARN != nil {
+		in, out := &in.SourceTableARN, &out.SourceTableARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceTableName != nil {
+		in, out := &in.SourceTableName, &out.SourceTableName
+		*out = new(string)
+		**out = **in
+	}
+	if in.UseLatestRestorableTime != nil {
+		in, out := &in.UseLatestRestorableTime, &out.UseLatestRestorableTime
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreTableToPointInTimeSpecification.
+func (in *RestoreTableToPointInTimeSpecification) DeepCopy() *RestoreTableToPointInTimeSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreTableToPointInTimeSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3BucketSource) DeepCopyInto(out *S3BucketSource) {
+	*out = *in
+	if in.S3Bucket != nil {
+		in, out := &in.S3Bucket, &out.S3Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3BucketOwner != nil {
+		in, out := &in.S3BucketOwner, &out.S3BucketOwner
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3KeyPrefix != nil {
+		in, out := &in.S3KeyPrefix, &out.S3KeyPrefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3BucketSource.
+func (in *S3BucketSource) DeepCopy() *S3BucketSource {
+	if in == nil {
+		return nil
+	}
+	out := new(S3BucketSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSEDescription) DeepCopyInto(out *SSEDescription) {
 	*out = *in
@@ -2577,6 +3380,11 @@ func (in *TableDescription) DeepCopyInto(out *TableDescription) {
 			}
 		}
 	}
+	if in.OnDemandThroughput != nil {
+		in, out := &in.OnDemandThroughput, &out.OnDemandThroughput
+		*out = new(OnDemandThroughput)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProvisionedThroughput != nil {
 		in, out := &in.ProvisionedThroughput, &out.ProvisionedThroughput
 		*out = new(ProvisionedThroughputDescription)
@@ -2717,6 +3525,11 @@ func (in *TableSpec) DeepCopyInto(out *TableSpec) {
 			}
 		}
 	}
+	if in.ImportSourceSpecification != nil {
+		in, out := &in.ImportSourceSpecification, &out.ImportSourceSpecification
+		*out = new(ImportSourceSpecification)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.KeySchema != nil {
 		in, out := &in.KeySchema, &out.KeySchema
 		*out = make([]*KeySchemaElement, len(*in))
@@ -2728,6 +3541,17 @@ func (in *TableSpec) DeepCopyInto(out *TableSpec) {
 			}
 		}
 	}
+	if in.KinesisStreamDestinations != nil {
+		in, out := &in.KinesisStreamDestinations, &out.KinesisStreamDestinations
+		*out = make([]*KinesisDataStreamDestination, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(KinesisDataStreamDestination)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.LocalSecondaryIndexes != nil {
 		in, out := &in.LocalSecondaryIndexes, &out.LocalSecondaryIndexes
 		*out = make([]*LocalSecondaryIndex, len(*in))
@@ -2739,11 +3563,47 @@ func (in *TableSpec) DeepCopyInto(out *TableSpec) {
 			}
 		}
 	}
+	if in.MultiRegionConsistency != nil {
+		in, out := &in.MultiRegionConsistency, &out.MultiRegionConsistency
+		*out = new(string)
+		**out = **in
+	}
+	if in.OnDemandThroughput != nil {
+		in, out := &in.OnDemandThroughput, &out.OnDemandThroughput
+		*out = new(OnDemandThroughput)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProvisionedThroughput != nil {
 		in, out := &in.ProvisionedThroughput, &out.ProvisionedThroughput
 		*out = new(ProvisionedThroughput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReplicaAutoScaling != nil {
+		in, out := &in.ReplicaAutoScaling, &out.ReplicaAutoScaling
+		*out = make([]*ReplicaAutoScalingUpdate, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ReplicaAutoScalingUpdate)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ResourcePolicy != nil {
+		in, out := &in.ResourcePolicy, &out.ResourcePolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestoreFrom != nil {
+		in, out := &in.RestoreFrom, &out.RestoreFrom
+		*out = new(RestoreTableToPointInTimeSpecification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RestoreSourceBackupARN != nil {
+		in, out := &in.RestoreSourceBackupARN, &out.RestoreSourceBackupARN
+		*out = new(string)
+		**out = **in
+	}
 	if in.SSESpecification != nil {
 		in, out := &in.SSESpecification, &out.SSESpecification
 		*out = new(SSESpecification)
@@ -2791,6 +3651,11 @@ func (in *TableSpec) DeepCopyInto(out *TableSpec) {
 		*out = new(TimeToLiveSpecification)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WarmThroughput != nil {
+		in, out := &in.WarmThroughput, &out.WarmThroughput
+		*out = new(WarmThroughput)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableSpec.
@@ -2827,10 +3692,25 @@ func (in *TableStatus) DeepCopyInto(out *TableStatus) {
 		*out = new(ArchivalSummary)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BillingModeSummary != nil {
+		in, out := &in.BillingModeSummary, &out.BillingModeSummary
+		*out = new(BillingModeSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContinuousBackupsDescription != nil {
+		in, out := &in.ContinuousBackupsDescription, &out.ContinuousBackupsDescription
+		*out = new(ContinuousBackupsDescription)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CreationDateTime != nil {
 		in, out := &in.CreationDateTime, &out.CreationDateTime
 		*out = (*in).DeepCopy()
 	}
+	if in.DeletionProtectionEnabled != nil {
+		in, out := &in.DeletionProtectionEnabled, &out.DeletionProtectionEnabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.GlobalSecondaryIndexesDescriptions != nil {
 		in, out := &in.GlobalSecondaryIndexesDescriptions, &out.GlobalSecondaryIndexesDescriptions
 		*out = make([]*GlobalSecondaryIndexDescription, len(*in))
@@ -2847,6 +3727,11 @@ func (in *TableStatus) DeepCopyInto(out *TableStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = new(ImportTableDescription)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ItemCount != nil {
 		in, out := &in.ItemCount, &out.ItemCount
 		*out = new(int64)
@@ -2862,6 +3747,43 @@ func (in *TableStatus) DeepCopyInto(out *TableStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LocalSecondaryIndexesDescriptions != nil {
+		in, out := &in.LocalSecondaryIndexesDescriptions, &out.LocalSecondaryIndexesDescriptions
+		*out = make([]*LocalSecondaryIndexDescription, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(LocalSecondaryIndexDescription)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.MultiRegionConsistency != nil {
+		in, out := &in.MultiRegionConsistency, &out.MultiRegionConsistency
+		*out = new(string)
+		**out = **in
+	}
+	if in.PointInTimeRecovery != nil {
+		in, out := &in.PointInTimeRecovery, &out.PointInTimeRecovery
+		*out = new(PointInTimeRecoveryDescription)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProvisionedThroughputDescription != nil {
+		in, out := &in.ProvisionedThroughputDescription, &out.ProvisionedThroughputDescription
+		*out = new(ProvisionedThroughputDescription)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicaAutoScalingDescriptions != nil {
+		in, out := &in.ReplicaAutoScalingDescriptions, &out.ReplicaAutoScalingDescriptions
+		*out = make([]*ReplicaAutoScalingDescription, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ReplicaAutoScalingDescription)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = make([]*ReplicaDescription, len(*in))
@@ -2878,6 +3800,16 @@ func (in *TableStatus) DeepCopyInto(out *TableStatus) {
 		*out = new(RestoreSummary)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SSEDescription != nil {
+		in, out := &in.SSEDescription, &out.SSEDescription
+		*out = new(SSEDescription)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TableClassSummary != nil {
+		in, out := &in.TableClassSummary, &out.TableClassSummary
+		*out = new(TableClassSummary)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TableID != nil {
 		in, out := &in.TableID, &out.TableID
 		*out = new(string)
@@ -2893,6 +3825,16 @@ func (in *TableStatus) DeepCopyInto(out *TableStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.TimeToLiveDescription != nil {
+		in, out := &in.TimeToLiveDescription, &out.TimeToLiveDescription
+		*out = new(TimeToLiveDescription)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WarmThroughputDescription != nil {
+		in, out := &in.WarmThroughputDescription, &out.WarmThroughputDescription
+		*out = new(TableWarmThroughputDescription)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableStatus.
@@ -2905,6 +3847,36 @@ func (in *TableStatus) DeepCopy() *TableStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableWarmThroughputDescription) DeepCopyInto(out *TableWarmThroughputDescription) {
+	*out = *in
+	if in.ReadUnitsPerSecond != nil {
+		in, out := &in.ReadUnitsPerSecond, &out.ReadUnitsPerSecond
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.WriteUnitsPerSecond != nil {
+		in, out := &in.WriteUnitsPerSecond, &out.WriteUnitsPerSecond
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableWarmThroughputDescription.
+func (in *TableWarmThroughputDescription) DeepCopy() *TableWarmThroughputDescription {
+	if in == nil {
+		return nil
+	}
+	out := new(TableWarmThroughputDescription)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
@@ -3008,11 +3980,21 @@ func (in *UpdateGlobalSecondaryIndexAction) DeepCopyInto(out *UpdateGlobalSecond
 		*out = new(string)
 		**out = **in
 	}
+	if in.OnDemandThroughput != nil {
+		in, out := &in.OnDemandThroughput, &out.OnDemandThroughput
+		*out = new(OnDemandThroughput)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProvisionedThroughput != nil {
 		in, out := &in.ProvisionedThroughput, &out.ProvisionedThroughput
 		*out = new(ProvisionedThroughput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WarmThroughput != nil {
+		in, out := &in.WarmThroughput, &out.WarmThroughput
+		*out = new(WarmThroughput)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateGlobalSecondaryIndexAction.
@@ -3044,6 +4026,11 @@ func (in *UpdateReplicationGroupMemberAction) DeepCopyInto(out *UpdateReplicatio
 		*out = new(string)
 		**out = **in
 	}
+	if in.OnDemandThroughputOverride != nil {
+		in, out := &in.OnDemandThroughputOverride, &out.OnDemandThroughputOverride
+		*out = new(OnDemandThroughputOverride)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProvisionedThroughputOverride != nil {
 		in, out := &in.ProvisionedThroughputOverride, &out.ProvisionedThroughputOverride
 		*out = new(ProvisionedThroughputOverride)
@@ -3070,3 +4057,28 @@ func (in *UpdateReplicationGroupMemberAction) DeepCopy() *UpdateReplicationGroup
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarmThroughput) DeepCopyInto(out *WarmThroughput) {
+	*out = *in
+	if in.ReadUnitsPerSecond != nil {
+		in, out := &in.ReadUnitsPerSecond, &out.ReadUnitsPerSecond
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WriteUnitsPerSecond != nil {
+		in, out := &in.WriteUnitsPerSecond, &out.WriteUnitsPerSecond
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarmThroughput.
+func (in *WarmThroughput) DeepCopy() *WarmThroughput {
+	if in == nil {
+		return nil
+	}
+	out := new(WarmThroughput)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -39,10 +39,12 @@ import (
 
 	svctypes "github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
 	svcresource "github.com/aws-controllers-k8s/dynamodb-controller/pkg/resource"
+	"github.com/aws-controllers-k8s/dynamodb-controller/pkg/sdkclient"
 
 	_ "github.com/aws-controllers-k8s/dynamodb-controller/pkg/resource/backup"
+	_ "github.com/aws-controllers-k8s/dynamodb-controller/pkg/resource/export"
 	_ "github.com/aws-controllers-k8s/dynamodb-controller/pkg/resource/global_table"
-	_ "github.com/aws-controllers-k8s/dynamodb-controller/pkg/resource/table"
+	tableresource "github.com/aws-controllers-k8s/dynamodb-controller/pkg/resource/table"
 
 	"github.com/aws-controllers-k8s/dynamodb-controller/pkg/version"
 )
@@ -65,6 +67,10 @@ func init() {
 func main() {
 	var ackCfg ackcfg.Config
 	ackCfg.BindFlags()
+	// The DynamoDB client used by the resource managers can be tuned with a
+	// per-call timeout and retry limits; register those flags alongside the
+	// runtime's own.
+	sdkclient.BindFlags(flag.CommandLine)
 	flag.Parse()
 	ackCfg.SetupLogger()
 
@@ -142,6 +148,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	// The Table hooks record Kubernetes Events describing each mutating
+	// DynamoDB call; hand them the manager's event recorder.
+	tableresource.SetEventRecorder(
+		mgr.GetEventRecorderFor(awsServiceAlias + "-controller"),
+	)
+
 	stopChan := ctrlrt.SetupSignalHandler()
 
 	setupLog.Info(
@@ -42,6 +42,7 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	customPreCompare(delta, a, b)
 
 	if ackcompare.HasNilDifference(a.ko.Spec.BackupName, b.ko.Spec.BackupName) {
 		delta.Add("Spec.BackupName", a.ko.Spec.BackupName, b.ko.Spec.BackupName)
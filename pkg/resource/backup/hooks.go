@@ -16,6 +16,8 @@ package backup
 import (
 	"errors"
 
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
 
 	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
@@ -34,6 +36,14 @@ var (
 	)
 )
 
+// deletionPolicyIsRetain returns true if the resource carries the ACK
+// deletion-policy annotation with the "retain" value, meaning the actual
+// backup must be orphaned rather than deleted when the CR goes away.
+func deletionPolicyIsRetain(r *resource) bool {
+	return r.ko.ObjectMeta.GetAnnotations()[ackv1alpha1.AnnotationDeletionPolicy] ==
+		string(ackv1alpha1.DeletionPolicyRetain)
+}
+
 // backupHasTerminalStatus returns whether the supplied backup is in a
 // terminal state
 func backupHasTerminalStatus(r *resource) bool {
@@ -58,3 +68,30 @@ func isBackupCreating(r *resource) bool {
 	dbis := *r.ko.Status.BackupStatus
 	return dbis == string(v1alpha1.BackupStatus_SDK_CREATING)
 }
+
+// isBackupAvailable returns true if the supplied Dynamodb backup is in an
+// available state
+func isBackupAvailable(r *resource) bool {
+	if r.ko.Status.BackupStatus == nil {
+		return false
+	}
+	dbis := *r.ko.Status.BackupStatus
+	return dbis == string(v1alpha1.BackupStatus_SDK_AVAILABLE)
+}
+
+// isBackupDeleted returns true if the supplied Dynamodb backup has been
+// deleted or has expired out of band
+func isBackupDeleted(r *resource) bool {
+	if r.ko.Status.BackupStatus == nil {
+		return false
+	}
+	dbis := *r.ko.Status.BackupStatus
+	return dbis == string(v1alpha1.BackupStatus_SDK_DELETED)
+}
+
+// isBackupNotFoundError returns true when a DeleteBackup call failed because
+// the backup no longer exists, for example after it was deleted out of band.
+func isBackupNotFoundError(err error) bool {
+	awsErr, ok := ackerr.AWSError(err)
+	return ok && awsErr.ErrorCode() == "BackupNotFoundException"
+}
@@ -0,0 +1,169 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package backup
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+	svcapitypes "github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+// setSourceTableDetails copies the source table's shape and the features that
+// were enabled on it at backup time from the DescribeBackup response into the
+// resource status. The metadata is immutable once the backup exists, so a
+// response that omits it (DescribeBackup trims it for backups being deleted)
+// leaves the previously observed values in place.
+func setSourceTableDetails(
+	ko *svcapitypes.Backup,
+	backupDescription *svcsdktypes.BackupDescription,
+) {
+	if backupDescription == nil {
+		return
+	}
+	if details := sourceTableDetailsFromSDK(backupDescription.SourceTableDetails); details != nil {
+		ko.Status.SourceTableDetails = details
+	}
+	if features := sourceTableFeatureDetailsFromSDK(backupDescription.SourceTableFeatureDetails); features != nil {
+		ko.Status.SourceTableFeatureDetails = features
+	}
+}
+
+// sourceTableDetailsFromSDK converts the SDK shape of the source table
+// details into its status representation.
+func sourceTableDetailsFromSDK(in *svcsdktypes.SourceTableDetails) *v1alpha1.SourceTableDetails {
+	if in == nil {
+		return nil
+	}
+	out := &v1alpha1.SourceTableDetails{
+		ItemCount:      in.ItemCount,
+		KeySchema:      keySchemaFromSDK(in.KeySchema),
+		TableARN:       in.TableArn,
+		TableID:        in.TableId,
+		TableName:      in.TableName,
+		TableSizeBytes: in.TableSizeBytes,
+	}
+	if in.BillingMode != "" {
+		out.BillingMode = aws.String(string(in.BillingMode))
+	}
+	if in.ProvisionedThroughput != nil {
+		out.ProvisionedThroughput = &v1alpha1.ProvisionedThroughput{
+			ReadCapacityUnits:  in.ProvisionedThroughput.ReadCapacityUnits,
+			WriteCapacityUnits: in.ProvisionedThroughput.WriteCapacityUnits,
+		}
+	}
+	if in.TableCreationDateTime != nil {
+		out.TableCreationDateTime = &metav1.Time{Time: *in.TableCreationDateTime}
+	}
+	return out
+}
+
+// sourceTableFeatureDetailsFromSDK converts the SDK shape of the source
+// table's feature details into its status representation.
+func sourceTableFeatureDetailsFromSDK(in *svcsdktypes.SourceTableFeatureDetails) *v1alpha1.SourceTableFeatureDetails {
+	if in == nil {
+		return nil
+	}
+	out := &v1alpha1.SourceTableFeatureDetails{}
+	for _, gsi := range in.GlobalSecondaryIndexes {
+		gsiInfo := &v1alpha1.GlobalSecondaryIndexInfo{
+			IndexName:  gsi.IndexName,
+			KeySchema:  keySchemaFromSDK(gsi.KeySchema),
+			Projection: projectionFromSDK(gsi.Projection),
+		}
+		if gsi.ProvisionedThroughput != nil {
+			gsiInfo.ProvisionedThroughput = &v1alpha1.ProvisionedThroughput{
+				ReadCapacityUnits:  gsi.ProvisionedThroughput.ReadCapacityUnits,
+				WriteCapacityUnits: gsi.ProvisionedThroughput.WriteCapacityUnits,
+			}
+		}
+		out.GlobalSecondaryIndexes = append(out.GlobalSecondaryIndexes, gsiInfo)
+	}
+	for _, lsi := range in.LocalSecondaryIndexes {
+		out.LocalSecondaryIndexes = append(out.LocalSecondaryIndexes, &v1alpha1.LocalSecondaryIndexInfo{
+			IndexName:  lsi.IndexName,
+			KeySchema:  keySchemaFromSDK(lsi.KeySchema),
+			Projection: projectionFromSDK(lsi.Projection),
+		})
+	}
+	if in.SSEDescription != nil {
+		sse := &v1alpha1.SSEDescription{
+			KMSMasterKeyARN: in.SSEDescription.KMSMasterKeyArn,
+		}
+		if in.SSEDescription.InaccessibleEncryptionDateTime != nil {
+			sse.InaccessibleEncryptionDateTime = &metav1.Time{Time: *in.SSEDescription.InaccessibleEncryptionDateTime}
+		}
+		if in.SSEDescription.SSEType != "" {
+			sse.SSEType = aws.String(string(in.SSEDescription.SSEType))
+		}
+		if in.SSEDescription.Status != "" {
+			sse.Status = aws.String(string(in.SSEDescription.Status))
+		}
+		out.SSEDescription = sse
+	}
+	if in.StreamDescription != nil {
+		stream := &v1alpha1.StreamSpecification{
+			StreamEnabled: in.StreamDescription.StreamEnabled,
+		}
+		if in.StreamDescription.StreamViewType != "" {
+			stream.StreamViewType = aws.String(string(in.StreamDescription.StreamViewType))
+		}
+		out.StreamDescription = stream
+	}
+	if in.TimeToLiveDescription != nil {
+		ttl := &v1alpha1.TimeToLiveDescription{
+			AttributeName: in.TimeToLiveDescription.AttributeName,
+		}
+		if in.TimeToLiveDescription.TimeToLiveStatus != "" {
+			ttl.TimeToLiveStatus = aws.String(string(in.TimeToLiveDescription.TimeToLiveStatus))
+		}
+		out.TimeToLiveDescription = ttl
+	}
+	return out
+}
+
+// keySchemaFromSDK converts a key schema from its SDK shape.
+func keySchemaFromSDK(in []svcsdktypes.KeySchemaElement) []*v1alpha1.KeySchemaElement {
+	out := make([]*v1alpha1.KeySchemaElement, 0, len(in))
+	for _, ks := range in {
+		elem := &v1alpha1.KeySchemaElement{
+			AttributeName: ks.AttributeName,
+		}
+		if ks.KeyType != "" {
+			elem.KeyType = aws.String(string(ks.KeyType))
+		}
+		out = append(out, elem)
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// projectionFromSDK converts an index projection from its SDK shape.
+func projectionFromSDK(in *svcsdktypes.Projection) *v1alpha1.Projection {
+	if in == nil {
+		return nil
+	}
+	out := &v1alpha1.Projection{}
+	for _, attr := range in.NonKeyAttributes {
+		out.NonKeyAttributes = append(out.NonKeyAttributes, aws.String(attr))
+	}
+	if in.ProjectionType != "" {
+		out.ProjectionType = aws.String(string(in.ProjectionType))
+	}
+	return out
+}
@@ -0,0 +1,104 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func Test_setSourceTableDetails(t *testing.T) {
+	created := time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)
+	ko := &v1alpha1.Backup{}
+	setSourceTableDetails(ko, &svcsdktypes.BackupDescription{
+		SourceTableDetails: &svcsdktypes.SourceTableDetails{
+			BillingMode: svcsdktypes.BillingModeProvisioned,
+			ItemCount:   aws.Int64(1200),
+			KeySchema: []svcsdktypes.KeySchemaElement{
+				{AttributeName: aws.String("pk"), KeyType: svcsdktypes.KeyTypeHash},
+				{AttributeName: aws.String("sk"), KeyType: svcsdktypes.KeyTypeRange},
+			},
+			ProvisionedThroughput: &svcsdktypes.ProvisionedThroughput{
+				ReadCapacityUnits:  aws.Int64(5),
+				WriteCapacityUnits: aws.Int64(5),
+			},
+			TableArn:              aws.String("arn:aws:dynamodb:us-west-2:111122223333:table/source"),
+			TableCreationDateTime: &created,
+			TableName:             aws.String("source"),
+			TableSizeBytes:        aws.Int64(4096),
+		},
+		SourceTableFeatureDetails: &svcsdktypes.SourceTableFeatureDetails{
+			GlobalSecondaryIndexes: []svcsdktypes.GlobalSecondaryIndexInfo{
+				{
+					IndexName: aws.String("email-index"),
+					KeySchema: []svcsdktypes.KeySchemaElement{
+						{AttributeName: aws.String("email"), KeyType: svcsdktypes.KeyTypeHash},
+					},
+					Projection: &svcsdktypes.Projection{
+						ProjectionType: svcsdktypes.ProjectionTypeAll,
+					},
+				},
+			},
+			StreamDescription: &svcsdktypes.StreamSpecification{
+				StreamEnabled:  aws.Bool(true),
+				StreamViewType: svcsdktypes.StreamViewTypeNewAndOldImages,
+			},
+			TimeToLiveDescription: &svcsdktypes.TimeToLiveDescription{
+				AttributeName:    aws.String("expires_at"),
+				TimeToLiveStatus: svcsdktypes.TimeToLiveStatusEnabled,
+			},
+		},
+	})
+
+	details := ko.Status.SourceTableDetails
+	if details == nil {
+		t.Fatalf("setSourceTableDetails() did not populate Status.SourceTableDetails")
+	}
+	if *details.TableARN != "arn:aws:dynamodb:us-west-2:111122223333:table/source" ||
+		*details.BillingMode != "PROVISIONED" ||
+		*details.TableSizeBytes != 4096 ||
+		!details.TableCreationDateTime.Time.Equal(created) {
+		t.Errorf("SourceTableDetails = %+v, not converted faithfully", details)
+	}
+	if len(details.KeySchema) != 2 || *details.KeySchema[1].KeyType != "RANGE" {
+		t.Errorf("SourceTableDetails.KeySchema = %v, want the full two-element schema", details.KeySchema)
+	}
+
+	features := ko.Status.SourceTableFeatureDetails
+	if features == nil {
+		t.Fatalf("setSourceTableDetails() did not populate Status.SourceTableFeatureDetails")
+	}
+	if len(features.GlobalSecondaryIndexes) != 1 ||
+		*features.GlobalSecondaryIndexes[0].IndexName != "email-index" ||
+		*features.GlobalSecondaryIndexes[0].Projection.ProjectionType != "ALL" {
+		t.Errorf("SourceTableFeatureDetails.GlobalSecondaryIndexes = %v, want email-index with ALL projection", features.GlobalSecondaryIndexes)
+	}
+	if features.StreamDescription == nil || *features.StreamDescription.StreamViewType != "NEW_AND_OLD_IMAGES" {
+		t.Errorf("SourceTableFeatureDetails.StreamDescription = %v, want NEW_AND_OLD_IMAGES", features.StreamDescription)
+	}
+	if features.TimeToLiveDescription == nil || *features.TimeToLiveDescription.TimeToLiveStatus != "ENABLED" {
+		t.Errorf("SourceTableFeatureDetails.TimeToLiveDescription = %v, want ENABLED", features.TimeToLiveDescription)
+	}
+
+	// A later response without the metadata must not wipe what was observed.
+	setSourceTableDetails(ko, &svcsdktypes.BackupDescription{})
+	if ko.Status.SourceTableDetails == nil || ko.Status.SourceTableFeatureDetails == nil {
+		t.Errorf("setSourceTableDetails() dropped previously observed details on an empty response")
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	ackutil "github.com/aws-controllers-k8s/runtime/pkg/util"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+// reservedTagKeyPrefix is the prefix of tag keys that are owned by AWS
+// services. These tags cannot be added or removed through the tagging APIs,
+// so the controller leaves them alone.
+const reservedTagKeyPrefix = "aws:"
+
+// AnnotationTagManagementPolicy is an annotation whose value selects how the
+// controller reconciles the backup's tags against Spec.Tags:
+// "authoritative" (the default) adds, updates and removes tags until the
+// server matches the spec exactly; "merge" only adds and updates keys the
+// spec declares and never removes others; "none" skips tag reconciliation
+// entirely after create. The merge and none policies are meant for tags
+// owned by an external governance tool.
+const AnnotationTagManagementPolicy = "dynamodb.services.k8s.aws/tag-management-policy"
+
+const (
+	tagPolicyAuthoritative = "authoritative"
+	tagPolicyMerge         = "merge"
+	tagPolicyNone          = "none"
+)
+
+// tagManagementPolicy returns the tag management policy selected by the
+// resource's annotations, defaulting to authoritative for a missing or
+// unrecognized value.
+func tagManagementPolicy(r *resource) string {
+	switch r.ko.ObjectMeta.GetAnnotations()[AnnotationTagManagementPolicy] {
+	case tagPolicyMerge:
+		return tagPolicyMerge
+	case tagPolicyNone:
+		return tagPolicyNone
+	}
+	return tagPolicyAuthoritative
+}
+
+// customPreCompare ensures that fields the generated delta helper cannot
+// compare are still detected. Tags are compared regardless of the order of
+// their elements.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	// The tag-management-policy annotation narrows what counts as a tag
+	// difference: merge only considers the keys the spec declares, none
+	// ignores tags entirely.
+	if policy := tagManagementPolicy(a); policy != tagPolicyNone {
+		added, removed := computeTagsDelta(a.ko.Spec.Tags, b.ko.Spec.Tags)
+		if policy == tagPolicyMerge {
+			removed = nil
+		}
+		if len(added) > 0 || len(removed) > 0 {
+			delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
+		}
+	}
+}
+
+// customUpdateBackup is the only update path for a backup. The backed up
+// data itself is immutable; the only mutable piece of state is the tag set
+// attached to the backup's ARN.
+func (rm *resourceManager) customUpdateBackup(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateBackup")
+	defer exit(err)
+
+	if delta.DifferentAt("Spec.BackupName") || delta.DifferentAt("Spec.TableName") {
+		return nil, ackerr.NewTerminalError(ackerr.NotImplemented)
+	}
+	if delta.DifferentAt("Spec.Tags") {
+		// TagResource rejects a backup that is still being created; wait
+		// for it to become available.
+		if isBackupCreating(latest) {
+			return desired, requeueWaitWhileCreating
+		}
+		if err := rm.syncBackupTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+
+	ko := desired.ko.DeepCopy()
+	rm.setStatusDefaults(ko)
+	return &resource{ko}, nil
+}
+
+// syncBackupTags updates the tags attached to the backup's ARN to match the
+// spec. A backup can carry at most 50 tags, which fits in a single
+// TagResource/UntagResource call.
+func (rm *resourceManager) syncBackupTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncBackupTags")
+	defer exit(err)
+
+	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
+	added, removed := computeTagsDelta(desired.ko.Spec.Tags, latest.ko.Spec.Tags)
+	switch tagManagementPolicy(desired) {
+	case tagPolicyNone:
+		return nil
+	case tagPolicyMerge:
+		removed = nil
+	}
+
+	if len(removed) > 0 {
+		_, err = rm.sdkapi.UntagResource(
+			ctx,
+			&svcsdk.UntagResourceInput{
+				ResourceArn: arn,
+				TagKeys:     removed,
+			},
+		)
+		rm.metrics.RecordAPICall("GET", "UntagResource", err)
+		if err != nil {
+			return err
+		}
+	}
+	if len(added) > 0 {
+		_, err = rm.sdkapi.TagResource(
+			ctx,
+			&svcsdk.TagResourceInput{
+				ResourceArn: arn,
+				Tags:        sdkTagsFromResourceTags(added),
+			},
+		)
+		rm.metrics.RecordAPICall("GET", "TagResource", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getBackupTags returns the tags currently attached to the backup's ARN,
+// following NextToken until the listing is exhausted.
+func (rm *resourceManager) getBackupTags(ctx context.Context, resourceARN string) ([]*v1alpha1.Tag, error) {
+	tags := []*v1alpha1.Tag{}
+
+	var token *string = nil
+	for {
+		resp, err := rm.sdkapi.ListTagsOfResource(
+			ctx,
+			&svcsdk.ListTagsOfResourceInput{
+				NextToken:   token,
+				ResourceArn: &resourceARN,
+			},
+		)
+		rm.metrics.RecordAPICall("GET", "ListTagsOfResource", err)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, resourceTagsFromSDKTags(resp.Tags)...)
+		if resp.NextToken == nil {
+			break
+		}
+		token = resp.NextToken
+	}
+	return tags, nil
+}
+
+// equalTags returns true if two Tag arrays are equal regardless of the order
+// of their elements.
+func equalTags(
+	a []*v1alpha1.Tag,
+	b []*v1alpha1.Tag,
+) bool {
+	added, removed := computeTagsDelta(a, b)
+	return len(added) == 0 && len(removed) == 0
+}
+
+// resourceTagsFromSDKTags transforms a *svcsdk.Tag array to a *v1alpha1.Tag array.
+func resourceTagsFromSDKTags(svcTags []svcsdktypes.Tag) []*v1alpha1.Tag {
+	tags := make([]*v1alpha1.Tag, len(svcTags))
+	for i := range svcTags {
+		tags[i] = &v1alpha1.Tag{
+			Key:   svcTags[i].Key,
+			Value: svcTags[i].Value,
+		}
+	}
+	return tags
+}
+
+// sdkTagsFromResourceTags transforms a *v1alpha1.Tag array to a *svcsdk.Tag array.
+func sdkTagsFromResourceTags(rTags []*v1alpha1.Tag) []svcsdktypes.Tag {
+	tags := make([]svcsdktypes.Tag, len(rTags))
+	for i := range rTags {
+		tags[i] = svcsdktypes.Tag{
+			Key:   rTags[i].Key,
+			Value: rTags[i].Value,
+		}
+	}
+	return tags
+}
+
+// computeTagsDelta compares two Tag arrays and returns the tags that need to
+// be added or updated and the keys of the tags that need to be removed.
+//
+// Keys with the reserved "aws:" prefix are skipped on both sides: they are
+// managed by AWS and any attempt to add or remove them is rejected by the
+// tagging APIs.
+func computeTagsDelta(
+	a []*v1alpha1.Tag,
+	b []*v1alpha1.Tag,
+) (added []*v1alpha1.Tag, removed []string) {
+	var visitedIndexes []string
+mainLoop:
+	for _, aElement := range b {
+		if strings.HasPrefix(*aElement.Key, reservedTagKeyPrefix) {
+			continue
+		}
+		visitedIndexes = append(visitedIndexes, *aElement.Key)
+		for _, bElement := range a {
+			if equalStrings(aElement.Key, bElement.Key) {
+				if !equalStrings(aElement.Value, bElement.Value) {
+					added = append(added, bElement)
+				}
+				continue mainLoop
+			}
+		}
+		removed = append(removed, *aElement.Key)
+	}
+	for _, bElement := range a {
+		if strings.HasPrefix(*bElement.Key, reservedTagKeyPrefix) {
+			continue
+		}
+		if !ackutil.InStrings(*bElement.Key, visitedIndexes) {
+			added = append(added, bElement)
+		}
+	}
+	return added, removed
+}
+
+func equalStrings(a, b *string) bool {
+	if a == nil {
+		return b == nil || *b == ""
+	}
+	return (*a == "" && b == nil) || *a == *b
+}
@@ -0,0 +1,221 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	smithy "github.com/aws/smithy-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func Test_sdkDelete_deletionPolicyRetain(t *testing.T) {
+	// rm has no SDK client wired up, so reaching DeleteBackup would panic
+	// the test; returning cleanly proves the retain guard short-circuited
+	// the delete.
+	rm := &resourceManager{}
+	arn := ackv1alpha1.AWSResourceName(
+		"arn:aws:dynamodb:us-west-2:111122223333:table/retained/backup/01234567890123-abcdefgh",
+	)
+	retained := &resource{ko: &v1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			ackv1alpha1.AnnotationDeletionPolicy: string(ackv1alpha1.DeletionPolicyRetain),
+		}},
+		Spec: v1alpha1.BackupSpec{BackupName: aws.String("retained")},
+		Status: v1alpha1.BackupStatus{
+			ACKResourceMetadata: &ackv1alpha1.ResourceMetadata{ARN: &arn},
+		},
+	}}
+	latest, err := rm.sdkDelete(context.Background(), retained)
+	if err != nil {
+		t.Fatalf("sdkDelete() with retain policy error = %v, want nil", err)
+	}
+	if latest != nil {
+		t.Fatalf("sdkDelete() with retain policy latest = %v, want nil", latest)
+	}
+
+	// Without the retain policy sdkDelete must proceed to DeleteBackup; with
+	// no SDK client wired up that call panics, which proves the guard did
+	// not short-circuit the delete.
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("sdkDelete() without retain policy never reached DeleteBackup")
+		}
+	}()
+	_, _ = rm.sdkDelete(context.Background(), &resource{ko: &v1alpha1.Backup{
+		Spec:   v1alpha1.BackupSpec{BackupName: aws.String("deleted")},
+		Status: v1alpha1.BackupStatus{ACKResourceMetadata: &ackv1alpha1.ResourceMetadata{ARN: &arn}},
+	}})
+}
+
+func Test_isBackupNotFoundError(t *testing.T) {
+	// DeleteBackup on a backup that was already deleted out of band must be
+	// treated as success so the finalizer comes off the CR.
+	if !isBackupNotFoundError(&smithy.GenericAPIError{
+		Code:    "BackupNotFoundException",
+		Message: "Backup not found",
+	}) {
+		t.Errorf("expected BackupNotFoundException to be recognized")
+	}
+	if isBackupNotFoundError(&smithy.GenericAPIError{Code: "BackupInUseException"}) {
+		t.Errorf("a busy backup is not a missing backup")
+	}
+	if isBackupNotFoundError(errors.New("connection reset")) {
+		t.Errorf("a non-AWS error is not a missing backup")
+	}
+}
+
+func Test_customPreCompare_tags(t *testing.T) {
+	newBackup := func(tags []*v1alpha1.Tag) *resource {
+		return &resource{ko: &v1alpha1.Backup{
+			Spec: v1alpha1.BackupSpec{
+				BackupName: aws.String("bk"),
+				Tags:       tags,
+			},
+		}}
+	}
+	tag := func(k, v string) *v1alpha1.Tag {
+		return &v1alpha1.Tag{Key: aws.String(k), Value: aws.String(v)}
+	}
+
+	shuffled := newResourceDelta(
+		newBackup([]*v1alpha1.Tag{tag("team", "data"), tag("env", "prod")}),
+		newBackup([]*v1alpha1.Tag{tag("env", "prod"), tag("team", "data")}),
+	)
+	if shuffled.DifferentAt("Spec.Tags") {
+		t.Errorf("tag order must not produce a delta")
+	}
+
+	changed := newResourceDelta(
+		newBackup([]*v1alpha1.Tag{tag("env", "prod")}),
+		newBackup([]*v1alpha1.Tag{tag("env", "dev")}),
+	)
+	if !changed.DifferentAt("Spec.Tags") {
+		t.Errorf("a changed tag value must produce a delta")
+	}
+
+	untagged := newResourceDelta(
+		newBackup([]*v1alpha1.Tag{tag("env", "prod")}),
+		newBackup(nil),
+	)
+	if !untagged.DifferentAt("Spec.Tags") {
+		t.Errorf("tags missing from the backup must produce a delta")
+	}
+}
+
+func Test_customUpdateBackup(t *testing.T) {
+	// rm has no SDK client wired up, so reaching TagResource would panic the
+	// test; a clean return proves no tagging call was issued.
+	rm := &resourceManager{}
+	arn := ackv1alpha1.AWSResourceName(
+		"arn:aws:dynamodb:us-west-2:111122223333:table/orders/backup/01234567890123-abcdefgh",
+	)
+	newBackup := func(status string, tags []*v1alpha1.Tag) *resource {
+		return &resource{ko: &v1alpha1.Backup{
+			Spec: v1alpha1.BackupSpec{
+				BackupName: aws.String("bk"),
+				Tags:       tags,
+			},
+			Status: v1alpha1.BackupStatus{
+				ACKResourceMetadata: &ackv1alpha1.ResourceMetadata{ARN: &arn},
+				BackupStatus:        aws.String(status),
+			},
+		}}
+	}
+	tags := []*v1alpha1.Tag{{Key: aws.String("env"), Value: aws.String("prod")}}
+
+	t.Run("a renamed backup is terminal", func(t *testing.T) {
+		desired := newBackup("AVAILABLE", nil)
+		desired.ko.Spec.BackupName = aws.String("renamed")
+		latest := newBackup("AVAILABLE", nil)
+		if _, err := rm.customUpdateBackup(
+			context.Background(), desired, latest, newResourceDelta(desired, latest),
+		); err == nil {
+			t.Fatalf("customUpdateBackup() did not reject a backup rename")
+		}
+	})
+
+	t.Run("tagging waits for the backup to become available", func(t *testing.T) {
+		desired := newBackup("CREATING", tags)
+		latest := newBackup("CREATING", nil)
+		_, err := rm.customUpdateBackup(
+			context.Background(), desired, latest, newResourceDelta(desired, latest),
+		)
+		if err != requeueWaitWhileCreating {
+			t.Fatalf("customUpdateBackup() error = %v, want requeueWaitWhileCreating", err)
+		}
+	})
+
+	t.Run("an available backup is tagged", func(t *testing.T) {
+		desired := newBackup("AVAILABLE", tags)
+		latest := newBackup("AVAILABLE", nil)
+		defer func() {
+			if recover() == nil {
+				t.Fatalf("customUpdateBackup() never reached TagResource")
+			}
+		}()
+		_, _ = rm.customUpdateBackup(
+			context.Background(), desired, latest, newResourceDelta(desired, latest),
+		)
+	})
+}
+
+func Test_tagManagementPolicy_modes(t *testing.T) {
+	// The backup carries an extra governance tag the spec does not declare.
+	newBackup := func(policy string, tags ...*v1alpha1.Tag) *resource {
+		r := &resource{ko: &v1alpha1.Backup{
+			Spec: v1alpha1.BackupSpec{
+				BackupName: aws.String("backup"),
+				Tags:       tags,
+			},
+		}}
+		if policy != "" {
+			r.ko.ObjectMeta.Annotations = map[string]string{
+				AnnotationTagManagementPolicy: policy,
+			}
+		}
+		return r
+	}
+	specTag := &v1alpha1.Tag{Key: aws.String("env"), Value: aws.String("prod")}
+	serverTags := []*v1alpha1.Tag{
+		{Key: aws.String("env"), Value: aws.String("prod")},
+		{Key: aws.String("cost-center"), Value: aws.String("1234")},
+	}
+
+	tests := []struct {
+		name      string
+		policy    string
+		wantDelta bool
+	}{
+		{name: "authoritative reports the extra server tag", policy: "", wantDelta: true},
+		{name: "merge tolerates the extra server tag", policy: "merge", wantDelta: false},
+		{name: "none skips tags entirely", policy: "none", wantDelta: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newBackup(tt.policy, specTag)
+			b := newBackup("", serverTags...)
+			delta := newResourceDelta(a, b)
+			if got := delta.DifferentAt("Spec.Tags"); got != tt.wantDelta {
+				t.Errorf("DifferentAt(Spec.Tags) = %v, want %v", got, tt.wantDelta)
+			}
+		})
+	}
+}
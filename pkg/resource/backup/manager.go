@@ -38,6 +38,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/dynamodb-controller/pkg/sdkclient"
 )
 
 var (
@@ -271,7 +272,7 @@ func (rm *resourceManager) IsSynced(ctx context.Context, res acktypes.AWSResourc
 	if r.ko.Status.BackupStatus == nil {
 		return false, nil
 	}
-	backupStatusCandidates := []string{"AVAILABLE", "DELETED"}
+	backupStatusCandidates := []string{"AVAILABLE"}
 	if !ackutil.InStrings(*r.ko.Status.BackupStatus, backupStatusCandidates) {
 		return false, nil
 	}
@@ -340,7 +341,7 @@ func newResourceManager(
 		rr:           rr,
 		awsAccountID: id,
 		awsRegion:    region,
-		sdkapi:       svcsdk.NewFromConfig(clientcfg),
+		sdkapi:       sdkclient.NewFromConfig(clientcfg),
 	}, nil
 }
 
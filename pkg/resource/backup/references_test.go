@@ -0,0 +1,56 @@
+package backup
+
+import (
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func Test_validateReferenceFields(t *testing.T) {
+	tableRef := &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String("my-table")},
+	}
+	tests := []struct {
+		name      string
+		tableName *string
+		tableRef  *ackv1alpha1.AWSResourceReferenceWrapper
+		wantErr   bool
+	}{
+		{
+			name:      "only tableName set",
+			tableName: aws.String("my-table"),
+		},
+		{
+			name:     "only tableRef set",
+			tableRef: tableRef,
+		},
+		{
+			name:      "both tableName and tableRef set",
+			tableName: aws.String("my-table"),
+			tableRef:  tableRef,
+			wantErr:   true,
+		},
+		{
+			name:    "neither tableName nor tableRef set",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ko := &v1alpha1.Backup{
+				Spec: v1alpha1.BackupSpec{
+					BackupName: aws.String("my-backup"),
+					TableName:  tt.tableName,
+					TableRef:   tt.tableRef,
+				},
+			}
+			err := validateReferenceFields(ko)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateReferenceFields() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -128,9 +128,28 @@ func (rm *resourceManager) sdkFind(
 	}
 
 	rm.setStatusDefaults(ko)
+	// DescribeBackup also returns the source table's shape and the features
+	// enabled on it when the backup was taken; surface them so the right
+	// backup for a restore can be picked from the CR alone.
+	setSourceTableDetails(ko, resp.BackupDescription)
+	// Tags do not come back on DescribeBackup; list them separately so the
+	// delta against the spec can be computed. The listing is skipped until
+	// the spec manages tags and the backup's ARN accepts tagging calls.
+	if len(ko.Spec.Tags) > 0 && ko.Status.ACKResourceMetadata.ARN != nil &&
+		isBackupAvailable(&resource{ko}) {
+		tags, err := rm.getBackupTags(ctx, string(*ko.Status.ACKResourceMetadata.ARN))
+		if err != nil {
+			return nil, err
+		}
+		ko.Spec.Tags = tags
+	}
 	if isBackupCreating(&resource{ko}) {
 		return &resource{ko}, requeueWaitWhileCreating
 	}
+	if isBackupDeleted(&resource{ko}) {
+		msg := "backup is no longer available: status is DELETED"
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	}
 	return &resource{ko}, nil
 }
 
@@ -254,7 +273,7 @@ func (rm *resourceManager) sdkUpdate(
 	latest *resource,
 	delta *ackcompare.Delta,
 ) (*resource, error) {
-	return nil, ackerr.NewTerminalError(ackerr.NotImplemented)
+	return rm.customUpdateBackup(ctx, desired, latest, delta)
 }
 
 // sdkDelete deletes the supplied resource in the backend AWS service API
@@ -267,6 +286,18 @@ func (rm *resourceManager) sdkDelete(
 	defer func() {
 		exit(err)
 	}()
+	// The ACK runtime removes the finalizer without calling Delete when the
+	// deletion policy is retain; this guard keeps the actual backup safe even
+	// if sdkDelete is reached through another path, and records which backup
+	// was orphaned.
+	if deletionPolicyIsRetain(r) {
+		arn := ""
+		if r.ko.Status.ACKResourceMetadata != nil && r.ko.Status.ACKResourceMetadata.ARN != nil {
+			arn = string(*r.ko.Status.ACKResourceMetadata.ARN)
+		}
+		rlog.Info("orphaning backup instead of deleting it - deletion policy set to retain", "arn", arn)
+		return nil, nil
+	}
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
 		return nil, err
@@ -275,6 +306,12 @@ func (rm *resourceManager) sdkDelete(
 	_ = resp
 	resp, err = rm.sdkapi.DeleteBackup(ctx, input)
 	rm.metrics.RecordAPICall("DELETE", "DeleteBackup", err)
+	if err != nil && isBackupNotFoundError(err) {
+		// The backup was already deleted out of band; treat the delete as
+		// successful so the finalizer is removed.
+		rlog.Info("backup already deleted out of band")
+		return nil, nil
+	}
 	return nil, err
 }
 
@@ -0,0 +1,49 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package export
+
+import (
+	"errors"
+
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+var (
+	requeueWaitWhileExporting = ackrequeue.NeededAfter(
+		errors.New("Export in 'IN_PROGRESS' state, waiting for it to complete."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// isExportInProgress returns true if the supplied DynamoDB export is still
+// running
+func isExportInProgress(r *resource) bool {
+	if r.ko.Status.ExportStatus == nil {
+		return false
+	}
+	es := *r.ko.Status.ExportStatus
+	return es == string(v1alpha1.ExportStatus_SDK_IN_PROGRESS)
+}
+
+// exportHasFailed returns true if the supplied DynamoDB export finished
+// unsuccessfully
+func exportHasFailed(r *resource) bool {
+	if r.ko.Status.ExportStatus == nil {
+		return false
+	}
+	es := *r.ko.Status.ExportStatus
+	return es == string(v1alpha1.ExportStatus_SDK_FAILED)
+}
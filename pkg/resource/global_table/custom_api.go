@@ -14,8 +14,30 @@
 package global_table
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	smithy "github.com/aws/smithy-go"
+
+	svcapitypes "github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+// requeueWaitWhileUpdating is returned while the global table reports
+// UPDATING; the legacy API rejects further replication group changes until
+// the current one has settled.
+var requeueWaitWhileUpdating = ackrequeue.NeededAfter(
+	fmt.Errorf("global table in '%v' state, cannot be modified",
+		svcsdktypes.GlobalTableStatusUpdating),
+	10*time.Second,
 )
 
 func customSetDeleteInput(r *resource, input *svcsdk.UpdateGlobalTableInput) {
@@ -28,3 +50,148 @@ func customSetDeleteInput(r *resource, input *svcsdk.UpdateGlobalTableInput) {
 		input.ReplicaUpdates = append(input.ReplicaUpdates, replicaUpdate)
 	}
 }
+
+// isGlobalTableUpdating returns true if the global table reports UPDATING.
+func isGlobalTableUpdating(r *resource) bool {
+	if r.ko.Status.GlobalTableStatus == nil {
+		return false
+	}
+	return *r.ko.Status.GlobalTableStatus == string(svcsdktypes.GlobalTableStatusUpdating)
+}
+
+// customUpdateGlobalTable reconciles the spec's replication group against
+// the observed one, one Region at a time: the legacy UpdateGlobalTable API
+// moves the global table to UPDATING for every change, so the next pending
+// Region is submitted only once the previous change has settled.
+func (rm *resourceManager) customUpdateGlobalTable(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateGlobalTable")
+	defer func() {
+		exit(err)
+	}()
+
+	if isGlobalTableUpdating(latest) {
+		return desired, requeueWaitWhileUpdating
+	}
+	input := newUpdateReplicationGroupPayload(desired, latest)
+	if input == nil {
+		return desired, nil
+	}
+
+	resp, err := rm.sdkapi.UpdateGlobalTable(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "UpdateGlobalTable", err)
+	if err != nil {
+		if terminalErr := replicaPreconditionError(err); terminalErr != nil {
+			return nil, terminalErr
+		}
+		return nil, err
+	}
+
+	ko := desired.ko.DeepCopy()
+	if resp.GlobalTableDescription.GlobalTableStatus != "" {
+		ko.Status.GlobalTableStatus = aws.String(string(resp.GlobalTableDescription.GlobalTableStatus))
+	}
+	setReplicationGroupDescriptions(ko, resp.GlobalTableDescription.ReplicationGroup)
+	// More Regions may still be pending, and the change just submitted has
+	// to settle before the next one can be sent; requeue either way.
+	return &resource{ko}, requeueWaitWhileUpdating
+}
+
+// newUpdateReplicationGroupPayload returns an UpdateGlobalTable input
+// carrying the first pending replication group change - a Region in the
+// spec but not on the global table is created before any removal - or nil
+// when the observed group already matches the spec. Only one ReplicaUpdate
+// is sent per call; the legacy API processes changes serially anyway.
+func newUpdateReplicationGroupPayload(
+	desired *resource,
+	latest *resource,
+) *svcsdk.UpdateGlobalTableInput {
+	latestRegions := map[string]bool{}
+	for _, replica := range latest.ko.Spec.ReplicationGroup {
+		latestRegions[aws.ToString(replica.RegionName)] = true
+	}
+	desiredRegions := map[string]bool{}
+	for _, replica := range desired.ko.Spec.ReplicationGroup {
+		desiredRegions[aws.ToString(replica.RegionName)] = true
+	}
+
+	var update *svcsdktypes.ReplicaUpdate
+	for _, replica := range desired.ko.Spec.ReplicationGroup {
+		if !latestRegions[aws.ToString(replica.RegionName)] {
+			update = &svcsdktypes.ReplicaUpdate{
+				Create: &svcsdktypes.CreateReplicaAction{
+					RegionName: replica.RegionName,
+				},
+			}
+			break
+		}
+	}
+	if update == nil {
+		for _, replica := range latest.ko.Spec.ReplicationGroup {
+			if !desiredRegions[aws.ToString(replica.RegionName)] {
+				update = &svcsdktypes.ReplicaUpdate{
+					Delete: &svcsdktypes.DeleteReplicaAction{
+						RegionName: replica.RegionName,
+					},
+				}
+				break
+			}
+		}
+	}
+	if update == nil {
+		return nil
+	}
+	return &svcsdk.UpdateGlobalTableInput{
+		GlobalTableName: desired.ko.Spec.GlobalTableName,
+		ReplicaUpdates:  []svcsdktypes.ReplicaUpdate{*update},
+	}
+}
+
+// replicaPreconditionError turns an UpdateGlobalTable failure that cannot
+// resolve on its own - the Region's table does not exist, or its key schema
+// or stream settings do not match the source table - into a terminal error
+// quoting the AWS message. Transient failures return nil and keep their
+// normal retry behavior.
+func replicaPreconditionError(err error) error {
+	var awsErr smithy.APIError
+	if !errors.As(err, &awsErr) {
+		return nil
+	}
+	switch awsErr.ErrorCode() {
+	case "TableNotFoundException", "ValidationException":
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"cannot update replication group: %s", awsErr.ErrorMessage(),
+		))
+	}
+	return nil
+}
+
+// setReplicationGroupDescriptions records the observed replication group,
+// including each replica's status, on the resource's status.
+func setReplicationGroupDescriptions(
+	ko *svcapitypes.GlobalTable,
+	replicationGroup []svcsdktypes.ReplicaDescription,
+) {
+	if replicationGroup == nil {
+		ko.Status.ReplicationGroupDescriptions = nil
+		return
+	}
+	descriptions := []*svcapitypes.ReplicaDescription{}
+	for _, replica := range replicationGroup {
+		description := &svcapitypes.ReplicaDescription{
+			RegionName:                   replica.RegionName,
+			ReplicaStatusDescription:     replica.ReplicaStatusDescription,
+			ReplicaStatusPercentProgress: replica.ReplicaStatusPercentProgress,
+		}
+		if replica.ReplicaStatus != "" {
+			description.ReplicaStatus = aws.String(string(replica.ReplicaStatus))
+		}
+		descriptions = append(descriptions, description)
+	}
+	ko.Status.ReplicationGroupDescriptions = descriptions
+}
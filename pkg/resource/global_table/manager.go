@@ -38,6 +38,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/dynamodb-controller/pkg/sdkclient"
 )
 
 var (
@@ -340,7 +341,7 @@ func newResourceManager(
 		rr:           rr,
 		awsAccountID: id,
 		awsRegion:    region,
-		sdkapi:       svcsdk.NewFromConfig(clientcfg),
+		sdkapi:       sdkclient.NewFromConfig(clientcfg),
 	}, nil
 }
 
@@ -33,5 +33,8 @@ func equalStrings(a, b *string) bool {
 	if a == nil {
 		return b == nil || *b == ""
 	}
-	return (*a == "" && b == nil) || *a == *b
+	if b == nil {
+		return *a == ""
+	}
+	return *a == *b
 }
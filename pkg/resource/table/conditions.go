@@ -14,12 +14,98 @@
 package table
 
 import (
+	"fmt"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
 )
 
+// ConditionTypeGSIsSyncing is the type of Condition set on a Table while its
+// global secondary indexes are being reconciled one create/delete at a time.
+const ConditionTypeGSIsSyncing = ackv1alpha1.ConditionType("GSIsSyncing")
+
+// ConditionTypeGSIsInSync is the type of Condition set on a Table reporting
+// whether all of its global secondary indexes are ACTIVE. While an index is
+// still CREATING or backfilling the condition is False and its message names
+// the indexes in progress.
+const ConditionTypeGSIsInSync = ackv1alpha1.ConditionType("GlobalSecondaryIndexesInSync")
+
+// ConditionTypeProgressDeadlineExceeded is the type of Condition set on a
+// Table that has stayed continuously non-ACTIVE for longer than the
+// configured progress deadline.
+const ConditionTypeProgressDeadlineExceeded = ackv1alpha1.ConditionType("ProgressDeadlineExceeded")
+
+// syncedMessagePathLimit caps how many differing field paths the
+// ResourceSynced condition message lists before truncating.
+const syncedMessagePathLimit = 10
+
+// syncedConditionDeltaMessage builds the ResourceSynced=False message for a
+// table with pending changes: the current table status followed by the
+// differing field paths from the computed delta, capped at
+// syncedMessagePathLimit entries. Only the paths are included - never the
+// values - so tag contents and resource policy documents cannot leak into
+// the condition. An empty delta yields a message saying the controller is
+// merely waiting for AWS to finish the previous operation.
+func syncedConditionDeltaMessage(latest *resource, delta *ackcompare.Delta) string {
+	msg := "table is being reconciled"
+	if latest.ko.Status.TableStatus != nil {
+		msg = "table is in '" + *latest.ko.Status.TableStatus + "' status"
+	}
+	paths := deltaPathStrings(delta)
+	if len(paths) == 0 {
+		return msg + "; the spec carries no further changes, waiting for the last operation to settle"
+	}
+	truncated := 0
+	if len(paths) > syncedMessagePathLimit {
+		truncated = len(paths) - syncedMessagePathLimit
+		paths = paths[:syncedMessagePathLimit]
+	}
+	for i, path := range paths {
+		paths[i] = fieldPathDisplay(path)
+	}
+	msg += "; differing fields: " + strings.Join(paths, ", ")
+	if truncated > 0 {
+		msg += fmt.Sprintf(" (and %d more)", truncated)
+	}
+	return msg
+}
+
+// fieldPathDisplay rewrites a delta path into the JSON field names users see
+// in their manifests, e.g. Spec.ProvisionedThroughput becomes
+// spec.provisionedThroughput and Spec.SSESpecification becomes
+// spec.sseSpecification.
+func fieldPathDisplay(path string) string {
+	parts := strings.Split(path, ".")
+	for i, part := range parts {
+		parts[i] = lowerLeadingAcronym(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// lowerLeadingAcronym lowercases the leading uppercase run of a path segment
+// while keeping the first letter of the following word intact, matching the
+// JSON tag convention of the generated API types.
+func lowerLeadingAcronym(s string) string {
+	i := 0
+	for i < len(s) && s[i] >= 'A' && s[i] <= 'Z' {
+		i++
+	}
+	if i == 0 {
+		return s
+	}
+	if i == len(s) {
+		return strings.ToLower(s)
+	}
+	if i > 1 {
+		i--
+	}
+	return strings.ToLower(s[:i]) + s[i:]
+}
+
 // getSyncedCondition returns the Condition in the resource's Conditions
 // collection that is of type ConditionTypeResourceSynced. If no such condition
 // is found, returns nil.
@@ -75,6 +161,69 @@ func setSyncedCondition(
 	now := metav1.Now()
 	c.LastTransitionTime = &now
 	c.Status = status
+	c.Message = message
+	c.Reason = reason
+}
+
+// setGSIsSyncingCondition sets the resource's Condition of type GSIsSyncing
+// to the supplied status and optional message. The message carries the number
+// of GSI updates still waiting to be applied.
+func setGSIsSyncingCondition(
+	r *resource,
+	status corev1.ConditionStatus,
+	message *string,
+) {
+	c := getConditionOfType(r, ConditionTypeGSIsSyncing)
+	if c == nil {
+		c = &ackv1alpha1.Condition{
+			Type: ConditionTypeGSIsSyncing,
+		}
+		r.ko.Status.Conditions = append(r.ko.Status.Conditions, c)
+	}
+	now := metav1.Now()
+	c.LastTransitionTime = &now
+	c.Status = status
+	c.Message = message
+}
+
+// setGSIsInSyncCondition sets the resource's Condition of type
+// GlobalSecondaryIndexesInSync to the supplied status and optional message.
+func setGSIsInSyncCondition(
+	r *resource,
+	status corev1.ConditionStatus,
+	message *string,
+) {
+	c := getConditionOfType(r, ConditionTypeGSIsInSync)
+	if c == nil {
+		c = &ackv1alpha1.Condition{
+			Type: ConditionTypeGSIsInSync,
+		}
+		r.ko.Status.Conditions = append(r.ko.Status.Conditions, c)
+	}
+	now := metav1.Now()
+	c.LastTransitionTime = &now
+	c.Status = status
+	c.Message = message
+}
+
+// setProgressDeadlineCondition sets the resource's Condition of type
+// ProgressDeadlineExceeded to the supplied status and optional message.
+func setProgressDeadlineCondition(
+	r *resource,
+	status corev1.ConditionStatus,
+	message *string,
+) {
+	c := getConditionOfType(r, ConditionTypeProgressDeadlineExceeded)
+	if c == nil {
+		c = &ackv1alpha1.Condition{
+			Type: ConditionTypeProgressDeadlineExceeded,
+		}
+		r.ko.Status.Conditions = append(r.ko.Status.Conditions, c)
+	}
+	now := metav1.Now()
+	c.LastTransitionTime = &now
+	c.Status = status
+	c.Message = message
 }
 
 // setTerminalCondition sets the resource's Condition of type
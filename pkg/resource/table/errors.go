@@ -0,0 +1,116 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+)
+
+// errorClass buckets an AWS API error by how the reconciler should react to
+// it.
+type errorClass int
+
+const (
+	// errorClassRetryNormal covers transient and unknown errors; they are
+	// surfaced as-is and retried with the reconciler's regular backoff.
+	errorClassRetryNormal errorClass = iota
+	// errorClassRetryFast covers errors that clear on their own shortly
+	// (the table is settling, or the control plane throttled the call);
+	// they become short requeues instead of reconciler errors.
+	errorClassRetryFast
+	// errorClassTerminal covers errors no amount of retrying can fix
+	// without a spec or account change; they set the ACK.Terminal
+	// condition.
+	errorClassTerminal
+)
+
+// tableBusyRequeueDelay is the wait applied when a mutating call hits a
+// fast-retryable error such as ResourceInUseException.
+const tableBusyRequeueDelay = 10 * time.Second
+
+// classifyAPIError assigns an AWS API error to one of the reaction buckets.
+// Callers with more specific knowledge (for example the TTL cooldown, which
+// also surfaces as a ValidationException) must run their own checks before
+// falling back to this classification.
+func classifyAPIError(err error) errorClass {
+	awsErr, ok := ackerr.AWSError(err)
+	if !ok {
+		return errorClassRetryNormal
+	}
+	switch awsErr.ErrorCode() {
+	case "AccessDeniedException",
+		"AccessDenied",
+		"ItemCollectionSizeLimitExceededException",
+		"ValidationException":
+		return errorClassTerminal
+	case "ResourceInUseException",
+		"ThrottlingException",
+		"LimitExceededException":
+		return errorClassRetryFast
+	}
+	return errorClassRetryNormal
+}
+
+// awsRequestID extracts the AWS request ID from an SDK response error, or
+// returns an empty string when the error does not carry one.
+func awsRequestID(err error) string {
+	var re interface{ ServiceRequestID() string }
+	if errors.As(err, &re) {
+		return re.ServiceRequestID()
+	}
+	return ""
+}
+
+// terminalAPIError wraps the supplied error in an ACK terminal error whose
+// message includes the AWS request ID when available, so the ACK.Terminal
+// condition gives support cases something to trace the failed call by.
+func terminalAPIError(err error) error {
+	if id := awsRequestID(err); id != "" {
+		return ackerr.NewTerminalError(fmt.Errorf("%w (AWS request ID: %s)", err, id))
+	}
+	return ackerr.NewTerminalError(err)
+}
+
+// mapAPIError applies classifyAPIError to an error returned by a DynamoDB
+// call: terminal errors become ACK terminal errors carrying the AWS request
+// ID, fast-retryable errors become short requeues (with the longer jittered
+// delay for control plane throttles), and everything else passes through to
+// the reconciler's normal backoff.
+func mapAPIError(err error) error {
+	if err == nil {
+		return nil
+	}
+	switch classifyAPIError(err) {
+	case errorClassTerminal:
+		return terminalAPIError(err)
+	case errorClassRetryFast:
+		if isThrottlingError(err) {
+			return throttleRequeue(err)
+		}
+		return ackrequeue.NeededAfter(err, tableBusyRequeueDelay)
+	}
+	return err
+}
+
+// isResourceNotFoundError returns true when a DynamoDB call failed because
+// the table no longer exists, for example after it was deleted out of band.
+func isResourceNotFoundError(err error) bool {
+	awsErr, ok := ackerr.AWSError(err)
+	return ok && awsErr.ErrorCode() == "ResourceNotFoundException"
+}
@@ -0,0 +1,152 @@
+package table
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	smithy "github.com/aws/smithy-go"
+)
+
+// apiErrorWithRequestID mimics an SDK response error that carries an AWS
+// request ID alongside the API error code.
+type apiErrorWithRequestID struct {
+	smithy.GenericAPIError
+	requestID string
+}
+
+func (e *apiErrorWithRequestID) ServiceRequestID() string { return e.requestID }
+
+func Test_classifyAPIError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want errorClass
+	}{
+		{
+			name: "access denied is terminal",
+			err:  &smithy.GenericAPIError{Code: "AccessDeniedException"},
+			want: errorClassTerminal,
+		},
+		{
+			name: "validation exception is terminal",
+			err:  &smithy.GenericAPIError{Code: "ValidationException"},
+			want: errorClassTerminal,
+		},
+		{
+			name: "item collection size limit is terminal",
+			err:  &smithy.GenericAPIError{Code: "ItemCollectionSizeLimitExceededException"},
+			want: errorClassTerminal,
+		},
+		{
+			name: "resource in use retries fast",
+			err:  &smithy.GenericAPIError{Code: "ResourceInUseException"},
+			want: errorClassRetryFast,
+		},
+		{
+			name: "throttling retries fast",
+			err:  &smithy.GenericAPIError{Code: "ThrottlingException"},
+			want: errorClassRetryFast,
+		},
+		{
+			name: "limit exceeded retries fast",
+			err:  &smithy.GenericAPIError{Code: "LimitExceededException"},
+			want: errorClassRetryFast,
+		},
+		{
+			name: "unknown API errors retry normally",
+			err:  &smithy.GenericAPIError{Code: "InternalServerError"},
+			want: errorClassRetryNormal,
+		},
+		{
+			name: "non-AWS errors retry normally",
+			err:  errors.New("dial tcp: connection refused"),
+			want: errorClassRetryNormal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyAPIError(tt.err); got != tt.want {
+				t.Errorf("classifyAPIError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_mapAPIError(t *testing.T) {
+	t.Run("nil passes through", func(t *testing.T) {
+		if got := mapAPIError(nil); got != nil {
+			t.Errorf("mapAPIError(nil) = %v, want nil", got)
+		}
+	})
+
+	t.Run("terminal errors wrap as ACK terminal errors", func(t *testing.T) {
+		err := mapAPIError(&smithy.GenericAPIError{
+			Code:    "AccessDeniedException",
+			Message: "not authorized to perform dynamodb:UpdateTable",
+		})
+		var termErr *ackerr.TerminalError
+		if !errors.As(err, &termErr) {
+			t.Fatalf("mapAPIError() error = %v, want a terminal error", err)
+		}
+		if !strings.Contains(err.Error(), "AccessDeniedException") {
+			t.Errorf("terminal error %q does not carry the error code", err.Error())
+		}
+	})
+
+	t.Run("the AWS request ID lands in the terminal message", func(t *testing.T) {
+		err := mapAPIError(&apiErrorWithRequestID{
+			GenericAPIError: smithy.GenericAPIError{Code: "ValidationException"},
+			requestID:       "E9S21KJJ0TEXAMPLE",
+		})
+		if !strings.Contains(err.Error(), "AWS request ID: E9S21KJJ0TEXAMPLE") {
+			t.Errorf("terminal error %q does not carry the request ID", err.Error())
+		}
+	})
+
+	t.Run("a busy table becomes a short requeue", func(t *testing.T) {
+		err := mapAPIError(&smithy.GenericAPIError{Code: "ResourceInUseException"})
+		var requeueErr *ackrequeue.RequeueNeededAfter
+		if !errors.As(err, &requeueErr) {
+			t.Fatalf("mapAPIError() error = %v, want a requeue error", err)
+		}
+		if requeueErr.Duration() != tableBusyRequeueDelay {
+			t.Errorf("requeue delay = %v, want %v", requeueErr.Duration(), tableBusyRequeueDelay)
+		}
+	})
+
+	t.Run("a throttle becomes a jittered requeue", func(t *testing.T) {
+		err := mapAPIError(&smithy.GenericAPIError{Code: "ThrottlingException"})
+		var requeueErr *ackrequeue.RequeueNeededAfter
+		if !errors.As(err, &requeueErr) {
+			t.Fatalf("mapAPIError() error = %v, want a requeue error", err)
+		}
+		if requeueErr.Duration() < throttleRequeueBaseDelay {
+			t.Errorf("requeue delay = %v, want at least %v", requeueErr.Duration(), throttleRequeueBaseDelay)
+		}
+	})
+
+	t.Run("other errors pass through for the normal backoff", func(t *testing.T) {
+		origErr := &smithy.GenericAPIError{Code: "InternalServerError"}
+		if err := mapAPIError(origErr); err != origErr {
+			t.Errorf("mapAPIError() error = %v, want %v", err, origErr)
+		}
+	})
+}
+
+func Test_isResourceNotFoundError(t *testing.T) {
+	if !isResourceNotFoundError(&smithy.GenericAPIError{
+		Code:    "ResourceNotFoundException",
+		Message: "Requested resource not found: Table: table not found",
+	}) {
+		t.Errorf("expected ResourceNotFoundException to be recognized")
+	}
+	if isResourceNotFoundError(&smithy.GenericAPIError{Code: "ResourceInUseException"}) {
+		t.Errorf("a busy table is not a missing table")
+	}
+	if isResourceNotFoundError(errors.New("connection reset")) {
+		t.Errorf("a non-AWS error is not a missing table")
+	}
+}
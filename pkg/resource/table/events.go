@@ -0,0 +1,191 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// eventRecorder records Kubernetes Events on Table resources so that every
+// mutating DynamoDB call leaves a visible trace on the CR, with a short
+// before/after summary of what changed. It stays nil until the controller
+// entry point wires in the manager's recorder; hooks go through
+// recordTableEvent, which tolerates the nil and turns into a no-op (unit
+// tests and older entry points simply record nothing).
+var eventRecorder record.EventRecorder
+
+// SetEventRecorder wires the Kubernetes event recorder used for Table
+// resources. It is called once from the controller entry point before any
+// reconcile runs.
+func SetEventRecorder(recorder record.EventRecorder) {
+	eventRecorder = recorder
+}
+
+// tableEventMinInterval is the minimum time between two identical events on
+// the same resource. A flapping diff re-applies the same change every
+// reconcile; without this floor each attempt would write an event to etcd.
+const tableEventMinInterval = time.Minute
+
+// lastEventTimes tracks when an identical event was last recorded per
+// resource, keyed by "namespace/name reason message".
+var lastEventTimes sync.Map
+
+// recordTableEvent records a Normal event on the Table resource, dropping
+// repeats of the same message within tableEventMinInterval.
+func recordTableEvent(r *resource, reason string, message string) {
+	recordTableEventWithType(r, corev1.EventTypeNormal, reason, message)
+}
+
+// recordTableWarningEvent records a Warning event on the Table resource,
+// with the same rate limiting as recordTableEvent.
+func recordTableWarningEvent(r *resource, reason string, message string) {
+	recordTableEventWithType(r, corev1.EventTypeWarning, reason, message)
+}
+
+// onceEmittedEvents tracks events that must only be recorded a single time
+// per resource for the lifetime of the process, keyed like lastEventTimes.
+var onceEmittedEvents sync.Map
+
+// recordTableWarningEventOnce records a Warning event the first time the
+// given resource/reason/message combination is seen and never again while
+// the process lives. It is meant for advisory messages that would otherwise
+// repeat every reconcile, such as spec fields the controller deliberately
+// ignores.
+func recordTableWarningEventOnce(r *resource, reason string, message string) {
+	if r == nil || r.ko == nil {
+		return
+	}
+	key := r.ko.Namespace + "/" + r.ko.Name + " " + reason + " " + message
+	if _, emitted := onceEmittedEvents.LoadOrStore(key, struct{}{}); emitted {
+		return
+	}
+	recordTableEventWithType(r, corev1.EventTypeWarning, reason, message)
+}
+
+func recordTableEventWithType(r *resource, eventType string, reason string, message string) {
+	if eventRecorder == nil || r == nil || r.ko == nil {
+		return
+	}
+	key := r.ko.Namespace + "/" + r.ko.Name + " " + reason + " " + message
+	now := time.Now()
+	if last, ok := lastEventTimes.Load(key); ok &&
+		now.Sub(last.(time.Time)) < tableEventMinInterval {
+		return
+	}
+	lastEventTimes.Store(key, now)
+	eventRecorder.Event(r.ko, eventType, reason, message)
+}
+
+// formatDeltaValue renders one side of a delta difference for an event
+// message, dereferencing pointers so a value prints as "5" rather than an
+// address. Nil prints as "<none>".
+func formatDeltaValue(v interface{}) string {
+	if v == nil {
+		return "<none>"
+	}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return "<none>"
+		}
+		rv = rv.Elem()
+	}
+	return fmt.Sprintf("%v", rv.Interface())
+}
+
+// describeDelta formats the given paths of an already-computed delta into a
+// short before/after summary, e.g. "BillingMode: PROVISIONED→PAY_PER_REQUEST".
+// Paths without a difference are left out.
+func describeDelta(delta *ackcompare.Delta, paths ...string) string {
+	var parts []string
+	for _, path := range paths {
+		for _, d := range delta.Differences {
+			if !d.Path.Contains(path) {
+				continue
+			}
+			parts = append(parts, fmt.Sprintf(
+				"%s: %s→%s",
+				strings.TrimPrefix(path, "Spec."),
+				formatDeltaValue(d.B), formatDeltaValue(d.A),
+			))
+			break
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatCapacityUnits renders a capacity unit count for an event message,
+// with "-" standing in for an unset value.
+func formatCapacityUnits(v *int64) string {
+	if v == nil {
+		return "-"
+	}
+	return strconv.FormatInt(*v, 10)
+}
+
+// describeGSIUpdateActions summarizes the index actions of an UpdateTable
+// input for an event message: "creating GSI \"a\"", "deleting GSI \"b\"",
+// "GSI \"c\": RCU 5→10, WCU 5→50". The previous throughput values come from
+// the last observed state of the table.
+func describeGSIUpdateActions(input *svcsdk.UpdateTableInput, latest *resource) string {
+	var parts []string
+	for _, update := range input.GlobalSecondaryIndexUpdates {
+		switch {
+		case update.Create != nil:
+			parts = append(parts, fmt.Sprintf("creating GSI %q", aws.ToString(update.Create.IndexName)))
+		case update.Delete != nil:
+			parts = append(parts, fmt.Sprintf("deleting GSI %q", aws.ToString(update.Delete.IndexName)))
+		case update.Update != nil:
+			name := aws.ToString(update.Update.IndexName)
+			detail := fmt.Sprintf("updating GSI %q", name)
+			if pt := update.Update.ProvisionedThroughput; pt != nil {
+				oldRCU, oldWCU := "-", "-"
+				for _, gsi := range latest.ko.Spec.GlobalSecondaryIndexes {
+					if aws.ToString(gsi.IndexName) != name || gsi.ProvisionedThroughput == nil {
+						continue
+					}
+					oldRCU = formatCapacityUnits(gsi.ProvisionedThroughput.ReadCapacityUnits)
+					oldWCU = formatCapacityUnits(gsi.ProvisionedThroughput.WriteCapacityUnits)
+					break
+				}
+				detail += fmt.Sprintf(
+					": RCU %s→%s, WCU %s→%s",
+					oldRCU, formatCapacityUnits(pt.ReadCapacityUnits),
+					oldWCU, formatCapacityUnits(pt.WriteCapacityUnits),
+				)
+			}
+			parts = append(parts, detail)
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// enabledOrDisabled renders a *bool toggle for an event message.
+func enabledOrDisabled(v *bool) string {
+	if aws.ToBool(v) {
+		return "enabled"
+	}
+	return "disabled"
+}
@@ -0,0 +1,91 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func Test_recordTableEvent_rateLimited(t *testing.T) {
+	fake := record.NewFakeRecorder(10)
+	eventRecorder = fake
+	defer func() { eventRecorder = nil }()
+
+	r := &resource{ko: &v1alpha1.Table{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "event-test"},
+	}}
+
+	// The same message twice within the rate-limit window records once.
+	recordTableEvent(r, "UpdatedTable", "BillingMode: PROVISIONED→PAY_PER_REQUEST")
+	recordTableEvent(r, "UpdatedTable", "BillingMode: PROVISIONED→PAY_PER_REQUEST")
+	// A different message is not held back.
+	recordTableEvent(r, "UpdatedTable", "TableClass: STANDARD→STANDARD_INFREQUENT_ACCESS")
+
+	if got := len(fake.Events); got != 2 {
+		t.Fatalf("expected 2 events recorded, got %d", got)
+	}
+	first := <-fake.Events
+	if !strings.Contains(first, "BillingMode") {
+		t.Errorf("unexpected first event: %q", first)
+	}
+}
+
+func Test_describeDelta(t *testing.T) {
+	delta := compare.NewDelta()
+	delta.Add("Spec.BillingMode", aws.String("PAY_PER_REQUEST"), aws.String("PROVISIONED"))
+	delta.Add("Spec.DeletionProtectionEnabled", aws.Bool(true), aws.Bool(false))
+
+	got := describeDelta(delta, "Spec.BillingMode", "Spec.TableClass", "Spec.DeletionProtectionEnabled")
+	want := "BillingMode: PROVISIONED→PAY_PER_REQUEST, DeletionProtectionEnabled: false→true"
+	if got != want {
+		t.Errorf("describeDelta() = %q, want %q", got, want)
+	}
+}
+
+func Test_describeGSIUpdateActions(t *testing.T) {
+	latest := tableResourceWithGSIs(testGSI("email-index", 5, 5))
+	input := &svcsdk.UpdateTableInput{
+		GlobalSecondaryIndexUpdates: []svcsdktypes.GlobalSecondaryIndexUpdate{
+			{
+				Update: &svcsdktypes.UpdateGlobalSecondaryIndexAction{
+					IndexName: aws.String("email-index"),
+					ProvisionedThroughput: &svcsdktypes.ProvisionedThroughput{
+						ReadCapacityUnits:  aws.Int64(5),
+						WriteCapacityUnits: aws.Int64(50),
+					},
+				},
+			},
+			{
+				Delete: &svcsdktypes.DeleteGlobalSecondaryIndexAction{
+					IndexName: aws.String("old-index"),
+				},
+			},
+		},
+	}
+	got := describeGSIUpdateActions(input, latest)
+	want := `updating GSI "email-index": RCU 5→5, WCU 5→50; deleting GSI "old-index"`
+	if got != want {
+		t.Errorf("describeGSIUpdateActions() = %q, want %q", got, want)
+	}
+}
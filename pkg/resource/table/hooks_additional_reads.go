@@ -0,0 +1,88 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	flag "github.com/spf13/pflag"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+// alwaysReadTableSettings restores the historical read-side behavior of
+// issuing ListTagsOfResource, DescribeTimeToLive, DescribeContinuousBackups
+// and GetResourcePolicy on every reconcile of every Table. By default those
+// calls are skipped for tables whose spec does not manage the corresponding
+// settings, which removes up to four control-plane calls per reconcile on
+// large fleets; the trade-off is that out-of-band changes to unmanaged
+// settings are no longer detected.
+var alwaysReadTableSettings bool
+
+func init() {
+	flag.BoolVar(
+		&alwaysReadTableSettings,
+		"always-read-table-settings",
+		false,
+		"Issue ListTagsOfResource, DescribeTimeToLive, DescribeContinuousBackups and "+
+			"GetResourcePolicy on every Table reconcile even when the spec does not "+
+			"manage the corresponding settings, so out-of-band changes to them are "+
+			"still detected.",
+	)
+}
+
+// shouldReadTags returns true when the reconcile needs the table's tags: the
+// spec manages them, or the always-read flag is set.
+func shouldReadTags(ko *v1alpha1.Table) bool {
+	return alwaysReadTableSettings || len(ko.Spec.Tags) > 0
+}
+
+// shouldReadTTL returns true when the reconcile needs the table's TTL
+// settings: the spec manages them, or a previous reconcile observed TTL as
+// anything other than disabled, in which case the setting keeps being watched
+// even after the spec section is removed.
+func shouldReadTTL(ko *v1alpha1.Table) bool {
+	if alwaysReadTableSettings || ko.Spec.TimeToLive != nil {
+		return true
+	}
+	return ko.Status.TimeToLiveDescription != nil &&
+		ko.Status.TimeToLiveDescription.TimeToLiveStatus != nil &&
+		*ko.Status.TimeToLiveDescription.TimeToLiveStatus != string(svcsdktypes.TimeToLiveStatusDisabled)
+}
+
+// shouldReadContinuousBackups returns true when the reconcile needs the
+// table's point-in-time recovery settings: the spec manages them, or a
+// previous reconcile observed PITR as enabled (the restorable-window
+// description is only populated for enabled tables).
+func shouldReadContinuousBackups(ko *v1alpha1.Table) bool {
+	return alwaysReadTableSettings ||
+		ko.Spec.ContinuousBackups != nil ||
+		ko.Status.PointInTimeRecovery != nil
+}
+
+// shouldReadResourcePolicy returns true when the reconcile needs the table's
+// resource-based policy: the spec manages it, or the always-read flag is set.
+func shouldReadResourcePolicy(ko *v1alpha1.Table) bool {
+	return alwaysReadTableSettings || ko.Spec.ResourcePolicy != nil
+}
+
+// shouldReadReplicaAutoScaling returns true when the reconcile needs the
+// replica auto scaling settings: the spec manages them, or a previous
+// reconcile observed them, so the described settings stay visible in the
+// status after the spec section is removed. The always-read flag is not
+// consulted because DescribeTableReplicaAutoScaling only applies to tables
+// with replicas.
+func shouldReadReplicaAutoScaling(ko *v1alpha1.Table) bool {
+	return len(ko.Spec.ReplicaAutoScaling) > 0 ||
+		len(ko.Status.ReplicaAutoScalingDescriptions) > 0
+}
@@ -0,0 +1,168 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func Test_shouldReadTableSettings(t *testing.T) {
+	tests := []struct {
+		name      string
+		ko        *v1alpha1.Table
+		predicate func(*v1alpha1.Table) bool
+		want      bool
+	}{
+		{
+			name:      "tags are not read when the spec has none",
+			ko:        &v1alpha1.Table{},
+			predicate: shouldReadTags,
+			want:      false,
+		},
+		{
+			name: "tags are read when the spec manages them",
+			ko: &v1alpha1.Table{
+				Spec: v1alpha1.TableSpec{
+					Tags: []*v1alpha1.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				},
+			},
+			predicate: shouldReadTags,
+			want:      true,
+		},
+		{
+			name:      "TTL is not read when neither spec nor status mention it",
+			ko:        &v1alpha1.Table{},
+			predicate: shouldReadTTL,
+			want:      false,
+		},
+		{
+			name: "TTL is read when the spec manages it",
+			ko: &v1alpha1.Table{
+				Spec: v1alpha1.TableSpec{
+					TimeToLive: &v1alpha1.TimeToLiveSpecification{Enabled: aws.Bool(true)},
+				},
+			},
+			predicate: shouldReadTTL,
+			want:      true,
+		},
+		{
+			name: "TTL keeps being read after the spec section is removed",
+			ko: &v1alpha1.Table{
+				Status: v1alpha1.TableStatus{
+					TimeToLiveDescription: &v1alpha1.TimeToLiveDescription{
+						TimeToLiveStatus: aws.String("ENABLED"),
+					},
+				},
+			},
+			predicate: shouldReadTTL,
+			want:      true,
+		},
+		{
+			name: "TTL observed disabled does not keep the read alive",
+			ko: &v1alpha1.Table{
+				Status: v1alpha1.TableStatus{
+					TimeToLiveDescription: &v1alpha1.TimeToLiveDescription{
+						TimeToLiveStatus: aws.String("DISABLED"),
+					},
+				},
+			},
+			predicate: shouldReadTTL,
+			want:      false,
+		},
+		{
+			name:      "PITR is not read when neither spec nor status mention it",
+			ko:        &v1alpha1.Table{},
+			predicate: shouldReadContinuousBackups,
+			want:      false,
+		},
+		{
+			name: "PITR is read when the spec manages it",
+			ko: &v1alpha1.Table{
+				Spec: v1alpha1.TableSpec{
+					ContinuousBackups: &v1alpha1.PointInTimeRecoverySpecification{
+						PointInTimeRecoveryEnabled: aws.Bool(true),
+					},
+				},
+			},
+			predicate: shouldReadContinuousBackups,
+			want:      true,
+		},
+		{
+			name: "PITR keeps being read while observed enabled",
+			ko: &v1alpha1.Table{
+				Status: v1alpha1.TableStatus{
+					PointInTimeRecovery: &v1alpha1.PointInTimeRecoveryDescription{},
+				},
+			},
+			predicate: shouldReadContinuousBackups,
+			want:      true,
+		},
+		{
+			name:      "resource policy is not read when the spec has none",
+			ko:        &v1alpha1.Table{},
+			predicate: shouldReadResourcePolicy,
+			want:      false,
+		},
+		{
+			name: "resource policy is read when the spec manages it",
+			ko: &v1alpha1.Table{
+				Spec: v1alpha1.TableSpec{ResourcePolicy: aws.String("{}")},
+			},
+			predicate: shouldReadResourcePolicy,
+			want:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.predicate(tt.ko); got != tt.want {
+				t.Errorf("predicate = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_setResourceAdditionalFields_skipsUnusedReads(t *testing.T) {
+	// rm has no SDK client wired up, so any auxiliary read would panic the
+	// test; a table whose spec uses none of the settings must complete the
+	// call with zero API requests.
+	rm := &resourceManager{}
+	arn := ackv1alpha1.AWSResourceName("arn:aws:dynamodb:us-west-2:111122223333:table/bare")
+	ko := &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{TableName: aws.String("bare")},
+		Status: v1alpha1.TableStatus{
+			ACKResourceMetadata: &ackv1alpha1.ResourceMetadata{ARN: &arn},
+		},
+	}
+	if err := rm.setResourceAdditionalFields(context.Background(), ko); err != nil {
+		t.Fatalf("setResourceAdditionalFields() error = %v, want nil", err)
+	}
+
+	// With the always-read flag set the same table reaches the first
+	// auxiliary call, which panics against the nil SDK client; that proves
+	// the flag restores the unconditional reads.
+	alwaysReadTableSettings = true
+	defer func() {
+		alwaysReadTableSettings = false
+		if recover() == nil {
+			t.Fatalf("setResourceAdditionalFields() with always-read flag never issued a read")
+		}
+	}()
+	_ = rm.setResourceAdditionalFields(context.Background(), ko)
+}
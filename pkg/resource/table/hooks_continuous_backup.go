@@ -15,27 +15,85 @@ package table
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
 )
 
+const (
+	minRecoveryPeriodInDays = 1
+	maxRecoveryPeriodInDays = 35
+)
+
+// requeueWaitContinuousBackupsAvailable is returned when continuous backups
+// are still being initialized on a freshly created table; point in time
+// recovery can be enabled as soon as that finishes.
+var requeueWaitContinuousBackupsAvailable = ackrequeue.NeededAfter(
+	errors.New("continuous backups are being initialized, cannot update point in time recovery settings"),
+	5*time.Second,
+)
+
+// mapContinuousBackupsError converts a ContinuousBackupsUnavailableException
+// into a short requeue instead of a reconciler error, since the condition
+// resolves itself shortly after table creation.
+func mapContinuousBackupsError(err error) error {
+	if awsErr, ok := ackerr.AWSError(err); ok &&
+		awsErr.ErrorCode() == "ContinuousBackupsUnavailableException" {
+		return requeueWaitContinuousBackupsAvailable
+	}
+	return err
+}
+
+// validateRecoveryPeriodInDays returns a terminal error if the spec recovery
+// period is outside the 1-35 day range accepted by UpdateContinuousBackups.
+func validateRecoveryPeriodInDays(pitrSpec *v1alpha1.PointInTimeRecoverySpecification) error {
+	if pitrSpec == nil || pitrSpec.RecoveryPeriodInDays == nil {
+		return nil
+	}
+	days := *pitrSpec.RecoveryPeriodInDays
+	if days < minRecoveryPeriodInDays || days > maxRecoveryPeriodInDays {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"continuousBackups.recoveryPeriodInDays must be between %d and %d, got %d",
+			minRecoveryPeriodInDays, maxRecoveryPeriodInDays, days,
+		))
+	}
+	return nil
+}
+
 // syncContinuousBackup syncs the PointInTimeRecoverySpecification of the dynamodb table.
 func (rm *resourceManager) syncContinuousBackup(
 	ctx context.Context,
 	desired *resource,
+	latest *resource,
 ) (err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.syncContinuousBackup")
 	defer func(err error) { exit(err) }(err)
 
+	if err := validateRecoveryPeriodInDays(desired.ko.Spec.ContinuousBackups); err != nil {
+		return err
+	}
+
 	pitrSpec := &svcsdktypes.PointInTimeRecoverySpecification{}
-	if desired.ko.Spec.ContinuousBackups != nil &&
-		desired.ko.Spec.ContinuousBackups.PointInTimeRecoveryEnabled != nil {
-		pitrSpec.PointInTimeRecoveryEnabled = desired.ko.Spec.ContinuousBackups.PointInTimeRecoveryEnabled
+	if desired.ko.Spec.ContinuousBackups != nil {
+		if desired.ko.Spec.ContinuousBackups.PointInTimeRecoveryEnabled != nil {
+			pitrSpec.PointInTimeRecoveryEnabled = desired.ko.Spec.ContinuousBackups.PointInTimeRecoveryEnabled
+		}
+		if desired.ko.Spec.ContinuousBackups.RecoveryPeriodInDays != nil {
+			pitrSpec.RecoveryPeriodInDays = aws.Int32(
+				int32(*desired.ko.Spec.ContinuousBackups.RecoveryPeriodInDays),
+			)
+		}
 	}
 
 	_, err = rm.sdkapi.UpdateContinuousBackups(
@@ -46,14 +104,27 @@ func (rm *resourceManager) syncContinuousBackup(
 		},
 	)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateContinuousBackups", err)
-	return err
+	recordSDKCall("UpdateContinuousBackups", err)
+	if err != nil {
+		return mapContinuousBackupsError(err)
+	}
+	oldPITR := "disabled"
+	if latest.ko.Spec.ContinuousBackups != nil {
+		oldPITR = enabledOrDisabled(latest.ko.Spec.ContinuousBackups.PointInTimeRecoveryEnabled)
+	}
+	recordTableEvent(desired, "UpdatedContinuousBackups", fmt.Sprintf(
+		"PITR: %s→%s", oldPITR, enabledOrDisabled(pitrSpec.PointInTimeRecoveryEnabled),
+	))
+	return nil
 }
 
-// getResourcePointInTimeRecoveryWithContext gets the PointInTimeRecoverySpecification of the dynamodb table.
+// getResourcePointInTimeRecoveryWithContext gets the PointInTimeRecoverySpecification
+// of the dynamodb table along with the description of the restorable window
+// and the full continuous backups description for the resource status.
 func (rm *resourceManager) getResourcePointInTimeRecoveryWithContext(
 	ctx context.Context,
 	tableName *string,
-) (*v1alpha1.PointInTimeRecoverySpecification, error) {
+) (*v1alpha1.PointInTimeRecoverySpecification, *v1alpha1.PointInTimeRecoveryDescription, *v1alpha1.ContinuousBackupsDescription, error) {
 	var err error
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.getResourcePointInTimeRecoveryWithContext")
@@ -67,16 +138,60 @@ func (rm *resourceManager) getResourcePointInTimeRecoveryWithContext(
 	)
 
 	rm.metrics.RecordAPICall("GET", "DescribeContinuousBackups", err)
+	recordSDKCall("DescribeContinuousBackups", err)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	isEnabled := false
+	pitrSpec := &v1alpha1.PointInTimeRecoverySpecification{}
+	var pitrDescription *v1alpha1.PointInTimeRecoveryDescription
+	cbDescription := continuousBackupsDescriptionFromSDK(res.ContinuousBackupsDescription)
 	if res.ContinuousBackupsDescription != nil {
-		isEnabled = res.ContinuousBackupsDescription.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus == svcsdktypes.PointInTimeRecoveryStatusEnabled
+		description := res.ContinuousBackupsDescription.PointInTimeRecoveryDescription
+		isEnabled = description.PointInTimeRecoveryStatus == svcsdktypes.PointInTimeRecoveryStatusEnabled
+		if isEnabled {
+			pitrDescription = cbDescription.PointInTimeRecoveryDescription
+			if description.RecoveryPeriodInDays != nil {
+				pitrSpec.RecoveryPeriodInDays = aws.Int64(int64(*description.RecoveryPeriodInDays))
+			}
+		}
 	}
+	pitrSpec.PointInTimeRecoveryEnabled = &isEnabled
 
-	return &v1alpha1.PointInTimeRecoverySpecification{
-		PointInTimeRecoveryEnabled: &isEnabled,
-	}, nil
+	return pitrSpec, pitrDescription, cbDescription, nil
+}
+
+// continuousBackupsDescriptionFromSDK copies a DescribeContinuousBackups
+// result into the resource status shape. Unlike the restorable-window
+// description, the result is populated even when point in time recovery is
+// disabled, so the status shows drift (e.g. PITR switched off in the
+// console) before the controller remediates it.
+func continuousBackupsDescriptionFromSDK(
+	description *svcsdktypes.ContinuousBackupsDescription,
+) *v1alpha1.ContinuousBackupsDescription {
+	if description == nil {
+		return nil
+	}
+	cbDescription := &v1alpha1.ContinuousBackupsDescription{}
+	if description.ContinuousBackupsStatus != "" {
+		cbDescription.ContinuousBackupsStatus = aws.String(string(description.ContinuousBackupsStatus))
+	}
+	if description.PointInTimeRecoveryDescription != nil {
+		pitr := &v1alpha1.PointInTimeRecoveryDescription{}
+		if description.PointInTimeRecoveryDescription.EarliestRestorableDateTime != nil {
+			pitr.EarliestRestorableDateTime = &metav1.Time{Time: *description.PointInTimeRecoveryDescription.EarliestRestorableDateTime}
+		}
+		if description.PointInTimeRecoveryDescription.LatestRestorableDateTime != nil {
+			pitr.LatestRestorableDateTime = &metav1.Time{Time: *description.PointInTimeRecoveryDescription.LatestRestorableDateTime}
+		}
+		if description.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus != "" {
+			pitr.PointInTimeRecoveryStatus = aws.String(string(description.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus))
+		}
+		if description.PointInTimeRecoveryDescription.RecoveryPeriodInDays != nil {
+			pitr.RecoveryPeriodInDays = aws.Int64(int64(*description.PointInTimeRecoveryDescription.RecoveryPeriodInDays))
+		}
+		cbDescription.PointInTimeRecoveryDescription = pitr
+	}
+	return cbDescription
 }
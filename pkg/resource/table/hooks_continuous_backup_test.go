@@ -0,0 +1,133 @@
+package table
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/smithy-go"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func Test_validateRecoveryPeriodInDays(t *testing.T) {
+	tests := []struct {
+		name     string
+		pitrSpec *v1alpha1.PointInTimeRecoverySpecification
+		wantErr  bool
+	}{
+		{
+			name:     "nil spec",
+			pitrSpec: nil,
+			wantErr:  false,
+		},
+		{
+			name: "unset period",
+			pitrSpec: &v1alpha1.PointInTimeRecoverySpecification{
+				PointInTimeRecoveryEnabled: aws.Bool(true),
+			},
+			wantErr: false,
+		},
+		{
+			name: "period in range",
+			pitrSpec: &v1alpha1.PointInTimeRecoverySpecification{
+				RecoveryPeriodInDays: aws.Int64(7),
+			},
+			wantErr: false,
+		},
+		{
+			name: "period below range",
+			pitrSpec: &v1alpha1.PointInTimeRecoverySpecification{
+				RecoveryPeriodInDays: aws.Int64(0),
+			},
+			wantErr: true,
+		},
+		{
+			name: "period above range",
+			pitrSpec: &v1alpha1.PointInTimeRecoverySpecification{
+				RecoveryPeriodInDays: aws.Int64(36),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateRecoveryPeriodInDays(tt.pitrSpec); (err != nil) != tt.wantErr {
+				t.Errorf("validateRecoveryPeriodInDays() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_continuousBackupsDescriptionFromSDK(t *testing.T) {
+	t.Run("nil description", func(t *testing.T) {
+		if got := continuousBackupsDescriptionFromSDK(nil); got != nil {
+			t.Errorf("continuousBackupsDescriptionFromSDK(nil) = %v, want nil", got)
+		}
+	})
+
+	t.Run("disabled PITR still shows up in the status", func(t *testing.T) {
+		got := continuousBackupsDescriptionFromSDK(&svcsdktypes.ContinuousBackupsDescription{
+			ContinuousBackupsStatus: svcsdktypes.ContinuousBackupsStatusEnabled,
+			PointInTimeRecoveryDescription: &svcsdktypes.PointInTimeRecoveryDescription{
+				PointInTimeRecoveryStatus: svcsdktypes.PointInTimeRecoveryStatusDisabled,
+			},
+		})
+		if aws.StringValue(got.ContinuousBackupsStatus) != "ENABLED" {
+			t.Errorf("ContinuousBackupsStatus = %v, want ENABLED", got.ContinuousBackupsStatus)
+		}
+		if got.PointInTimeRecoveryDescription == nil ||
+			aws.StringValue(got.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus) != "DISABLED" {
+			t.Errorf("PointInTimeRecoveryDescription = %+v, want status DISABLED", got.PointInTimeRecoveryDescription)
+		}
+	})
+
+	t.Run("restorable window is copied for enabled PITR", func(t *testing.T) {
+		earliest := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		latest := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+		got := continuousBackupsDescriptionFromSDK(&svcsdktypes.ContinuousBackupsDescription{
+			ContinuousBackupsStatus: svcsdktypes.ContinuousBackupsStatusEnabled,
+			PointInTimeRecoveryDescription: &svcsdktypes.PointInTimeRecoveryDescription{
+				PointInTimeRecoveryStatus:  svcsdktypes.PointInTimeRecoveryStatusEnabled,
+				EarliestRestorableDateTime: &earliest,
+				LatestRestorableDateTime:   &latest,
+				RecoveryPeriodInDays:       aws.Int32(30),
+			},
+		})
+		pitr := got.PointInTimeRecoveryDescription
+		if pitr == nil {
+			t.Fatal("expected a point in time recovery description")
+		}
+		if !pitr.EarliestRestorableDateTime.Time.Equal(earliest) ||
+			!pitr.LatestRestorableDateTime.Time.Equal(latest) {
+			t.Errorf("restorable window = %v–%v, want %v–%v",
+				pitr.EarliestRestorableDateTime, pitr.LatestRestorableDateTime, earliest, latest)
+		}
+		if aws.Int64Value(pitr.RecoveryPeriodInDays) != 30 {
+			t.Errorf("RecoveryPeriodInDays = %v, want 30", pitr.RecoveryPeriodInDays)
+		}
+	})
+}
+
+func Test_mapContinuousBackupsError(t *testing.T) {
+	t.Run("ContinuousBackupsUnavailableException becomes a requeue", func(t *testing.T) {
+		err := mapContinuousBackupsError(&smithy.GenericAPIError{
+			Code:    "ContinuousBackupsUnavailableException",
+			Message: "Backups are being enabled for the table",
+		})
+		var requeueErr *ackrequeue.RequeueNeededAfter
+		if !errors.As(err, &requeueErr) {
+			t.Errorf("mapContinuousBackupsError() error = %v, want a requeue error", err)
+		}
+	})
+
+	t.Run("other errors pass through", func(t *testing.T) {
+		origErr := &smithy.GenericAPIError{Code: "ValidationException"}
+		if err := mapContinuousBackupsError(origErr); err != origErr {
+			t.Errorf("mapContinuousBackupsError() error = %v, want %v", err, origErr)
+		}
+	})
+}
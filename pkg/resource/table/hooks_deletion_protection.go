@@ -0,0 +1,68 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"context"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+)
+
+// AnnotationForceDeleteProtection is an annotation whose value tells the
+// controller what to do when a Table CR is deleted while deletion protection
+// is still enabled on the actual table. The only supported value is "disable",
+// which makes the controller disable deletion protection before calling
+// DeleteTable. Without the annotation the controller surfaces a terminal
+// error instead of looping on the ValidationException DeleteTable returns.
+const AnnotationForceDeleteProtection = "services.k8s.aws/force-delete-protection"
+
+// canForceDeleteProtection returns true if the resource carries the
+// force-delete-protection annotation with the "disable" value.
+func canForceDeleteProtection(r *resource) bool {
+	return r.ko.ObjectMeta.GetAnnotations()[AnnotationForceDeleteProtection] == "disable"
+}
+
+// deletionPolicyIsRetain returns true if the resource carries the ACK
+// deletion-policy annotation with the "retain" value, meaning the actual
+// table must be orphaned rather than deleted when the CR goes away.
+func deletionPolicyIsRetain(r *resource) bool {
+	return r.ko.ObjectMeta.GetAnnotations()[ackv1alpha1.AnnotationDeletionPolicy] ==
+		string(ackv1alpha1.DeletionPolicyRetain)
+}
+
+// disableDeletionProtection disables deletion protection on the actual table
+// so that a subsequent DeleteTable call can succeed.
+func (rm *resourceManager) disableDeletionProtection(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.disableDeletionProtection")
+	defer exit(err)
+
+	input := &svcsdk.UpdateTableInput{
+		TableName:                 aws.String(*r.ko.Spec.TableName),
+		DeletionProtectionEnabled: aws.Bool(false),
+	}
+	_, err = rm.sdkapi.UpdateTable(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "UpdateTable", err)
+	recordSDKCall("UpdateTable", err)
+	if err != nil {
+		return err
+	}
+	return err
+}
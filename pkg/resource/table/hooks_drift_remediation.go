@@ -0,0 +1,55 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"encoding/json"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+)
+
+// AnnotationDriftRemediation is an annotation that controls what the
+// controller does when the table has drifted from the spec. The only
+// supported value is "observe", which makes the controller report the drift
+// (synced condition and event) without issuing any mutating call; create and
+// delete are unaffected. Removing the annotation resumes remediation on the
+// next reconcile. This is meant for tables whose capacity is managed by a
+// separate system.
+const AnnotationDriftRemediation = "dynamodb.services.k8s.aws/drift-remediation"
+
+// isDriftRemediationObserve returns true if the resource carries the
+// drift-remediation annotation with the "observe" value.
+func isDriftRemediationObserve(r *resource) bool {
+	return r.ko.ObjectMeta.GetAnnotations()[AnnotationDriftRemediation] == "observe"
+}
+
+// deltaPathStrings renders the differing field paths of a delta in dotted
+// notation, e.g. "Spec.BillingMode". The runtime's Path type only exposes
+// its parts through JSON marshalling.
+func deltaPathStrings(delta *ackcompare.Delta) []string {
+	var paths []string
+	for _, d := range delta.Differences {
+		encoded, err := json.Marshal(d.Path)
+		if err != nil {
+			continue
+		}
+		var path struct{ Parts []string }
+		if err := json.Unmarshal(encoded, &path); err != nil {
+			continue
+		}
+		paths = append(paths, strings.Join(path.Parts, "."))
+	}
+	return paths
+}
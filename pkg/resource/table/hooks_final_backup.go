@@ -0,0 +1,137 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// AnnotationFinalBackupName is an annotation whose value names an on-demand
+// backup the controller creates before deleting the table, mirroring the
+// RDS-style final snapshot. DeleteTable is only called once the backup has
+// reached AVAILABLE; until then the deletion requeues and the finalizer
+// stays in place.
+const AnnotationFinalBackupName = "dynamodb.services.k8s.aws/final-backup-name"
+
+// AnnotationSkipFinalBackup is an annotation whose value tells the
+// controller to delete the table without the final backup requested by the
+// final-backup-name annotation. The only supported value is "true". It is
+// the escape hatch for a backup that cannot be created (for example when
+// the account's backup quota is exhausted), which otherwise blocks the
+// deletion with a terminal condition.
+const AnnotationSkipFinalBackup = "dynamodb.services.k8s.aws/skip-final-backup"
+
+// requeueWaitFinalBackup is returned while the final backup is still being
+// created; backups of large tables take a while and DeleteTable must wait
+// for AVAILABLE.
+var requeueWaitFinalBackup = ackrequeue.NeededAfter(
+	errors.New("final backup is being created, waiting for it to become available before deleting the table"),
+	15*time.Second,
+)
+
+// finalBackupName returns the backup name from the final-backup-name
+// annotation, and false when the annotation is absent or empty.
+func finalBackupName(r *resource) (string, bool) {
+	name := r.ko.ObjectMeta.GetAnnotations()[AnnotationFinalBackupName]
+	return name, name != ""
+}
+
+// skipFinalBackup returns true if the resource carries the
+// skip-final-backup annotation with the "true" value.
+func skipFinalBackup(r *resource) bool {
+	return r.ko.ObjectMeta.GetAnnotations()[AnnotationSkipFinalBackup] == "true"
+}
+
+// mapFinalBackupError converts a failure to create the final backup into an
+// error that blocks the deletion: transient errors requeue, everything else
+// goes terminal with a message pointing at the skip-final-backup escape
+// hatch, since retrying the backup forever would leave the table stuck in
+// deletion without explanation.
+func mapFinalBackupError(name string, err error) error {
+	if classifyAPIError(err) == errorClassRetryFast {
+		return mapAPIError(err)
+	}
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"cannot create final backup %q, blocking table deletion: %w; set the %s=true annotation to delete the table without a final backup",
+		name, err, AnnotationSkipFinalBackup,
+	))
+}
+
+// ensureFinalBackup creates the named on-demand backup of the table unless
+// one already exists, and reports whether it has reached AVAILABLE. The
+// lookup goes through ListBackups because the backup's ARN is not persisted
+// between reconciles.
+func (rm *resourceManager) ensureFinalBackup(
+	ctx context.Context,
+	r *resource,
+	name string,
+) (available bool, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.ensureFinalBackup")
+	defer func() { exit(err) }()
+
+	listInput := &svcsdk.ListBackupsInput{
+		TableName:  r.ko.Spec.TableName,
+		BackupType: svcsdktypes.BackupTypeFilterUser,
+	}
+	for {
+		listResp, err := rm.sdkapi.ListBackups(ctx, listInput)
+		rm.metrics.RecordAPICall("READ_MANY", "ListBackups", err)
+		recordSDKCall("ListBackups", err)
+		if err != nil {
+			return false, mapFinalBackupError(name, err)
+		}
+		for _, summary := range listResp.BackupSummaries {
+			if summary.BackupName == nil || *summary.BackupName != name {
+				continue
+			}
+			switch summary.BackupStatus {
+			case svcsdktypes.BackupStatusAvailable:
+				return true, nil
+			case svcsdktypes.BackupStatusCreating:
+				return false, nil
+			}
+			// A DELETED backup with the same name does not protect the
+			// table's data; fall through and create a fresh one.
+		}
+		if listResp.LastEvaluatedBackupArn == nil {
+			break
+		}
+		listInput.ExclusiveStartBackupArn = listResp.LastEvaluatedBackupArn
+	}
+
+	_, err = rm.sdkapi.CreateBackup(ctx, &svcsdk.CreateBackupInput{
+		TableName:  r.ko.Spec.TableName,
+		BackupName: aws.String(name),
+	})
+	rm.metrics.RecordAPICall("CREATE", "CreateBackup", err)
+	recordSDKCall("CreateBackup", err)
+	if err != nil {
+		return false, mapFinalBackupError(name, err)
+	}
+	recordTableEvent(r, "CreatingFinalBackup", fmt.Sprintf(
+		"creating final backup %q before deleting table", name,
+	))
+	return false, nil
+}
@@ -0,0 +1,147 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	smithy "github.com/aws/smithy-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func Test_finalBackupAnnotations(t *testing.T) {
+	newTable := func(annotations map[string]string) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			Spec:       v1alpha1.TableSpec{TableName: aws.String("table")},
+		}}
+	}
+
+	if _, ok := finalBackupName(newTable(nil)); ok {
+		t.Errorf("expected no final backup without the annotation")
+	}
+	if _, ok := finalBackupName(newTable(map[string]string{
+		AnnotationFinalBackupName: "",
+	})); ok {
+		t.Errorf("expected an empty final-backup-name annotation to be ignored")
+	}
+	name, ok := finalBackupName(newTable(map[string]string{
+		AnnotationFinalBackupName: "pre-delete-orders",
+	}))
+	if !ok || name != "pre-delete-orders" {
+		t.Errorf("finalBackupName() = %q, %v, want pre-delete-orders, true", name, ok)
+	}
+
+	if skipFinalBackup(newTable(nil)) {
+		t.Errorf("expected skipFinalBackup to be false without the annotation")
+	}
+	if skipFinalBackup(newTable(map[string]string{
+		AnnotationSkipFinalBackup: "false",
+	})) {
+		t.Errorf("expected skipFinalBackup to require the \"true\" value")
+	}
+	if !skipFinalBackup(newTable(map[string]string{
+		AnnotationSkipFinalBackup: "true",
+	})) {
+		t.Errorf("expected skipFinalBackup to be true with the annotation")
+	}
+}
+
+func Test_mapFinalBackupError(t *testing.T) {
+	t.Run("transient errors requeue", func(t *testing.T) {
+		err := mapFinalBackupError("pre-delete-orders", &smithy.GenericAPIError{
+			Code:    "LimitExceededException",
+			Message: "too many concurrent backups",
+		})
+		var requeueErr *ackrequeue.RequeueNeededAfter
+		if !errors.As(err, &requeueErr) {
+			t.Fatalf("mapFinalBackupError() = %v, want a requeue for a transient error", err)
+		}
+	})
+	t.Run("other errors block the deletion with the escape hatch", func(t *testing.T) {
+		err := mapFinalBackupError("pre-delete-orders", &smithy.GenericAPIError{
+			Code:    "BackupUnavailableException",
+			Message: "backups are not available for this table",
+		})
+		var termErr *ackerr.TerminalError
+		if !errors.As(err, &termErr) {
+			t.Fatalf("mapFinalBackupError() = %v, want a terminal error", err)
+		}
+		for _, want := range []string{"pre-delete-orders", AnnotationSkipFinalBackup} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("mapFinalBackupError() message %q does not mention %s", err.Error(), want)
+			}
+		}
+	})
+}
+
+func Test_sdkDelete_finalBackup(t *testing.T) {
+	// rm has no SDK client wired up, so the panic (or its absence) shows
+	// which API call, if any, the delete path reaches first.
+	rm := &resourceManager{}
+	newTable := func(annotations map[string]string) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			Spec:       v1alpha1.TableSpec{TableName: aws.String("orders")},
+			Status:     v1alpha1.TableStatus{TableStatus: aws.String("ACTIVE")},
+		}}
+	}
+	deleteReachesAPI := func(r *resource) (reached bool) {
+		defer func() {
+			if recover() != nil {
+				reached = true
+			}
+		}()
+		rm.sdkDelete(context.Background(), r)
+		return false
+	}
+
+	t.Run("annotation routes the delete through the backup", func(t *testing.T) {
+		// The first call with the annotation is the backup lookup, which
+		// panics here on the nil SDK client; that it is reached before
+		// DeleteTable proves the table is not deleted without the backup.
+		if !deleteReachesAPI(newTable(map[string]string{
+			AnnotationFinalBackupName: "pre-delete-orders",
+		})) {
+			t.Errorf("expected sdkDelete to reach the backup lookup")
+		}
+	})
+	t.Run("skip annotation proceeds straight to the delete", func(t *testing.T) {
+		// With the skip annotation the backup machinery is bypassed and the
+		// first call reached is DeleteTable itself.
+		if !deleteReachesAPI(newTable(map[string]string{
+			AnnotationFinalBackupName: "pre-delete-orders",
+			AnnotationSkipFinalBackup: "true",
+		})) {
+			t.Errorf("expected sdkDelete to reach DeleteTable")
+		}
+	})
+	t.Run("a table already deleting requeues before any backup call", func(t *testing.T) {
+		r := newTable(map[string]string{
+			AnnotationFinalBackupName: "pre-delete-orders",
+		})
+		r.ko.Status.TableStatus = aws.String("DELETING")
+		if _, err := rm.sdkDelete(context.Background(), r); err != requeueWaitWhileDeleting {
+			t.Errorf("sdkDelete() while DELETING error = %v, want requeueWaitWhileDeleting", err)
+		}
+	})
+}
@@ -15,13 +15,18 @@ package table
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
 	ackutil "github.com/aws-controllers-k8s/runtime/pkg/util"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
 )
@@ -37,6 +42,105 @@ func canUpdateTableGSIs(r *resource) bool {
 	return true
 }
 
+// updateGSIsInSyncCondition recomputes the GlobalSecondaryIndexesInSync
+// condition from the GSI descriptions. While any index is not ACTIVE, the
+// condition is False and its message lists the indexes still in progress,
+// including the item count already copied into a backfilling index as a
+// rough progress indicator.
+func updateGSIsInSyncCondition(r *resource) {
+	var pending []string
+	for _, gsiDescription := range r.ko.Status.GlobalSecondaryIndexesDescriptions {
+		if gsiDescription.IndexStatus == nil ||
+			*gsiDescription.IndexStatus == string(svcsdktypes.IndexStatusActive) {
+			continue
+		}
+		detail := fmt.Sprintf("%s: %s", *gsiDescription.IndexName, *gsiDescription.IndexStatus)
+		if gsiDescription.Backfilling != nil && *gsiDescription.Backfilling {
+			detail += " (backfilling"
+			if gsiDescription.ItemCount != nil {
+				detail += fmt.Sprintf(", %d items copied", *gsiDescription.ItemCount)
+			}
+			detail += ")"
+		}
+		pending = append(pending, detail)
+	}
+	if len(pending) == 0 {
+		setGSIsInSyncCondition(r, corev1.ConditionTrue, nil)
+		return
+	}
+	msg := "global secondary indexes not yet ACTIVE: " + strings.Join(pending, "; ")
+	setGSIsInSyncCondition(r, corev1.ConditionFalse, &msg)
+}
+
+// AnnotationPreventGSIRecreate is an annotation that, when set to "true",
+// stops the controller from automatically deleting and recreating a global
+// secondary index whose key schema or projection changed in the spec. With
+// the annotation set the resource goes terminal instead, so a production
+// index is never dropped by accident.
+const AnnotationPreventGSIRecreate = "dynamodb.services.k8s.aws/prevent-gsi-recreate"
+
+// preventGSIRecreate returns true if the resource opted out of automatic
+// index recreation through the prevent-gsi-recreate annotation.
+func preventGSIRecreate(r *resource) bool {
+	return r.ko.ObjectMeta.GetAnnotations()[AnnotationPreventGSIRecreate] == "true"
+}
+
+// AnnotationUnmanagedGSIPolicy is an annotation that controls what the
+// controller does with global secondary indexes that exist on the table but
+// are not declared in Spec.GlobalSecondaryIndexes. By default the spec is
+// authoritative and such indexes are deleted; with the "retain" value they
+// are treated as unmanaged (created by another tool) and left alone, while
+// spec-declared indexes are still fully reconciled.
+const AnnotationUnmanagedGSIPolicy = "dynamodb.services.k8s.aws/unmanaged-gsi-policy"
+
+// retainUnmanagedGSIs returns true if the resource opted into keeping
+// server-side indexes absent from the spec through the unmanaged-gsi-policy
+// annotation.
+func retainUnmanagedGSIs(r *resource) bool {
+	return r.ko.ObjectMeta.GetAnnotations()[AnnotationUnmanagedGSIPolicy] == "retain"
+}
+
+// filterToDeclaredGSIs returns the members of latest whose index name is
+// declared in the desired spec; the remaining indexes are the unmanaged ones
+// under the retain policy.
+func filterToDeclaredGSIs(
+	declared []*v1alpha1.GlobalSecondaryIndex,
+	latest []*v1alpha1.GlobalSecondaryIndex,
+) []*v1alpha1.GlobalSecondaryIndex {
+	var managed []*v1alpha1.GlobalSecondaryIndex
+	for _, gsi := range latest {
+		for _, d := range declared {
+			if equalStrings(gsi.IndexName, d.IndexName) {
+				managed = append(managed, gsi)
+				break
+			}
+		}
+	}
+	return managed
+}
+
+// gsiRequiresRecreate returns true when the desired index differs from the
+// latest one in key schema or projection. DynamoDB cannot alter either on a
+// live index (only throughput is mutable), so applying such a change means
+// deleting the index and recreating it with the new definition.
+func gsiRequiresRecreate(desired, latest *v1alpha1.GlobalSecondaryIndex) bool {
+	if ackcompare.HasNilDifference(desired.Projection, latest.Projection) {
+		return true
+	}
+	if desired.Projection != nil && latest.Projection != nil {
+		if !equalStrings(desired.Projection.ProjectionType, latest.Projection.ProjectionType) {
+			return true
+		}
+		if !equalNonKeyAttributes(desired.Projection.NonKeyAttributes, latest.Projection.NonKeyAttributes) {
+			return true
+		}
+	}
+	if len(desired.KeySchema) != len(latest.KeySchema) {
+		return true
+	}
+	return len(desired.KeySchema) > 0 && !equalKeySchemaArrays(desired.KeySchema, latest.KeySchema)
+}
+
 // computeGlobalSecondaryIndexDelta compares two GlobalSecondaryIndex arrays and
 // return three different list containing the added, updated and removed
 // GlobalSecondaryIndex. The removed array only contains the IndexName of the
@@ -97,6 +201,12 @@ func equalGlobalSecondaryIndexes(
 			return false
 		}
 	}
+	if !equalOnDemandThroughputs(a.OnDemandThroughput, b.OnDemandThroughput) {
+		return false
+	}
+	if !equalWarmThroughputs(a.WarmThroughput, b.WarmThroughput) {
+		return false
+	}
 	if ackcompare.HasNilDifference(a.Projection, b.Projection) {
 		return false
 	}
@@ -104,7 +214,7 @@ func equalGlobalSecondaryIndexes(
 		if !equalStrings(a.Projection.ProjectionType, b.Projection.ProjectionType) {
 			return false
 		}
-		if !ackcompare.SliceStringPEqual(a.Projection.NonKeyAttributes, b.Projection.NonKeyAttributes) {
+		if !equalNonKeyAttributes(a.Projection.NonKeyAttributes, b.Projection.NonKeyAttributes) {
 			return false
 		}
 	}
@@ -141,6 +251,197 @@ func isPayPerRequestMode(desired *v1alpha1.GlobalSecondaryIndex, latest *v1alpha
 	return false
 }
 
+// tableBillsPayPerRequest returns true when the table's effective billing
+// mode is PAY_PER_REQUEST: the desired spec value when set, otherwise the
+// mode last observed on the table.
+func tableBillsPayPerRequest(desired, latest *resource) bool {
+	mode := desired.ko.Spec.BillingMode
+	if mode == nil {
+		mode = latest.ko.Spec.BillingMode
+	}
+	if mode == nil && latest.ko.Status.BillingModeSummary != nil {
+		mode = latest.ko.Status.BillingModeSummary.BillingMode
+	}
+	return aws.ToString(mode) == string(v1alpha1.BillingMode_PAY_PER_REQUEST)
+}
+
+// refreshTableGSIState re-reads the table and returns a copy of latest whose
+// global secondary index views are replaced by the fresh response. The delta
+// that queued a GSI action is computed at the top of the reconcile and can
+// be overtaken by the service before the mutation slot comes around:
+// removing a replica region briefly flips the remaining indexes to UPDATING,
+// and a delete issued on an earlier reconcile may have completed in the
+// meantime. Deriving the UpdateTable input from the state observed right
+// before the call drops actions that no longer apply instead of replaying a
+// stale delta into a ValidationException.
+func (rm *resourceManager) refreshTableGSIState(
+	ctx context.Context,
+	latest *resource,
+) (fresh *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.refreshTableGSIState")
+	defer exit(err)
+
+	resp, err := rm.sdkapi.DescribeTable(
+		ctx,
+		&svcsdk.DescribeTableInput{
+			TableName: latest.ko.Spec.TableName,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeTable", err)
+	recordSDKCall("DescribeTable", err)
+	if err != nil {
+		return nil, err
+	}
+	return refreshedGSIView(latest, resp.Table), nil
+}
+
+// refreshedGSIView returns a copy of latest whose Spec.GlobalSecondaryIndexes
+// and Status.GlobalSecondaryIndexesDescriptions mirror the given table
+// description; the rest of the resource is left as observed at the top of
+// the reconcile.
+func refreshedGSIView(
+	latest *resource,
+	table *svcsdktypes.TableDescription,
+) *resource {
+	ko := latest.ko.DeepCopy()
+	var gsis []*v1alpha1.GlobalSecondaryIndex
+	for _, gsiDescription := range table.GlobalSecondaryIndexes {
+		gsis = append(gsis, resourceGSIFromDescription(&gsiDescription))
+	}
+	ko.Spec.GlobalSecondaryIndexes = gsis
+	ko.Status.GlobalSecondaryIndexesDescriptions = gsiDescriptionsFromSDK(table.GlobalSecondaryIndexes)
+	return &resource{ko}
+}
+
+// gsiDescriptionsFromSDK converts the global secondary index descriptions of
+// a DescribeTable or CreateTable response into their status shape. The full
+// description is kept - notably the index ARN, which FieldExport consumers
+// read - and a table without indexes yields nil so repeated reads settle on
+// the same status.
+func gsiDescriptionsFromSDK(
+	gsiDescriptions []svcsdktypes.GlobalSecondaryIndexDescription,
+) []*v1alpha1.GlobalSecondaryIndexDescription {
+	if gsiDescriptions == nil {
+		return nil
+	}
+	f := []*v1alpha1.GlobalSecondaryIndexDescription{}
+	for _, fIter := range gsiDescriptions {
+		fElem := &v1alpha1.GlobalSecondaryIndexDescription{}
+		if fIter.IndexName != nil {
+			fElem.IndexName = fIter.IndexName
+		}
+		if fIter.IndexArn != nil {
+			fElem.IndexARN = fIter.IndexArn
+		}
+		if fIter.ItemCount != nil {
+			fElem.ItemCount = fIter.ItemCount
+		}
+		if fIter.IndexStatus != "" {
+			fElem.IndexStatus = aws.String(string(fIter.IndexStatus))
+		}
+		if fIter.IndexSizeBytes != nil {
+			fElem.IndexSizeBytes = fIter.IndexSizeBytes
+		}
+		if fIter.Backfilling != nil {
+			fElem.Backfilling = fIter.Backfilling
+		}
+		if fIter.WarmThroughput != nil {
+			wt := &v1alpha1.GlobalSecondaryIndexWarmThroughputDescription{}
+			if fIter.WarmThroughput.ReadUnitsPerSecond != nil {
+				wt.ReadUnitsPerSecond = fIter.WarmThroughput.ReadUnitsPerSecond
+			}
+			if fIter.WarmThroughput.WriteUnitsPerSecond != nil {
+				wt.WriteUnitsPerSecond = fIter.WarmThroughput.WriteUnitsPerSecond
+			}
+			if fIter.WarmThroughput.Status != "" {
+				wt.Status = aws.String(string(fIter.WarmThroughput.Status))
+			}
+			fElem.WarmThroughput = wt
+		}
+		f = append(f, fElem)
+	}
+	return f
+}
+
+// resourceGSIFromDescription converts a global secondary index description
+// into the spec shape the delta helpers compare against.
+func resourceGSIFromDescription(
+	gsiDescription *svcsdktypes.GlobalSecondaryIndexDescription,
+) *v1alpha1.GlobalSecondaryIndex {
+	gsi := &v1alpha1.GlobalSecondaryIndex{
+		IndexName: gsiDescription.IndexName,
+	}
+	for _, kse := range gsiDescription.KeySchema {
+		elem := &v1alpha1.KeySchemaElement{
+			AttributeName: kse.AttributeName,
+		}
+		if kse.KeyType != "" {
+			elem.KeyType = aws.String(string(kse.KeyType))
+		}
+		gsi.KeySchema = append(gsi.KeySchema, elem)
+	}
+	if gsiDescription.Projection != nil {
+		gsi.Projection = &v1alpha1.Projection{}
+		if gsiDescription.Projection.NonKeyAttributes != nil {
+			gsi.Projection.NonKeyAttributes = aws.StringSlice(gsiDescription.Projection.NonKeyAttributes)
+		}
+		if gsiDescription.Projection.ProjectionType != "" {
+			gsi.Projection.ProjectionType = aws.String(string(gsiDescription.Projection.ProjectionType))
+		}
+	}
+	if gsiDescription.ProvisionedThroughput != nil {
+		gsi.ProvisionedThroughput = &v1alpha1.ProvisionedThroughput{
+			ReadCapacityUnits:  gsiDescription.ProvisionedThroughput.ReadCapacityUnits,
+			WriteCapacityUnits: gsiDescription.ProvisionedThroughput.WriteCapacityUnits,
+		}
+	}
+	if gsiDescription.OnDemandThroughput != nil {
+		gsi.OnDemandThroughput = &v1alpha1.OnDemandThroughput{
+			MaxReadRequestUnits:  gsiDescription.OnDemandThroughput.MaxReadRequestUnits,
+			MaxWriteRequestUnits: gsiDescription.OnDemandThroughput.MaxWriteRequestUnits,
+		}
+	}
+	return gsi
+}
+
+// gsiThroughputDecrease returns true when the desired update lowers the
+// index's provisioned capacity below the value the table reports.
+func gsiThroughputDecrease(desired *v1alpha1.GlobalSecondaryIndex, latest *resource) bool {
+	for _, latestGSI := range latest.ko.Spec.GlobalSecondaryIndexes {
+		if equalStrings(desired.IndexName, latestGSI.IndexName) {
+			return isThroughputDecrease(desired.ProvisionedThroughput, latestGSI.ProvisionedThroughput)
+		}
+	}
+	return false
+}
+
+// gsiThroughputOnlyUpdates returns the indexes whose pending change is a
+// throughput update, and true when that is all the GSI delta carries - no
+// creations, deletions or recreates - so the updates can ride along with a
+// table-level throughput call instead of consuming a decrease of their own.
+func gsiThroughputOnlyUpdates(latest *resource, desired *resource) ([]*v1alpha1.GlobalSecondaryIndex, bool) {
+	addedGSIs, updatedGSIs, removedGSIs := computeGlobalSecondaryIndexDelta(
+		latest.ko.Spec.GlobalSecondaryIndexes,
+		desired.ko.Spec.GlobalSecondaryIndexes,
+	)
+	if len(removedGSIs) > 0 && retainUnmanagedGSIs(desired) {
+		removedGSIs = nil
+	}
+	if len(addedGSIs) > 0 || len(removedGSIs) > 0 || len(updatedGSIs) == 0 {
+		return nil, false
+	}
+	for _, updatedGSI := range updatedGSIs {
+		for _, latestGSI := range latest.ko.Spec.GlobalSecondaryIndexes {
+			if equalStrings(updatedGSI.IndexName, latestGSI.IndexName) &&
+				gsiRequiresRecreate(updatedGSI, latestGSI) {
+				return nil, false
+			}
+		}
+	}
+	return updatedGSIs, true
+}
+
 // syncTableGlobalSecondaryIndexes updates a global table secondary indexes.
 func (rm *resourceManager) syncTableGlobalSecondaryIndexes(
 	ctx context.Context,
@@ -151,6 +452,20 @@ func (rm *resourceManager) syncTableGlobalSecondaryIndexes(
 	exit := rlog.Trace("rm.syncTableGlobalSecondaryIndexes")
 	defer exit(err)
 
+	if !canUpdateTableGSIs(latest) {
+		return requeueWaitGSIReady
+	}
+	// The refreshed view below is rebuilt from DescribeTable and carries no
+	// contributor insights; keep the pre-refresh view around so deletes can
+	// tell whether the index had insights enabled.
+	observed := latest
+	// The index state captured at the top of the reconcile may no longer
+	// hold; re-read the table and build the mutation from what is actually
+	// there right now.
+	latest, err = rm.refreshTableGSIState(ctx, latest)
+	if err != nil {
+		return err
+	}
 	if !canUpdateTableGSIs(latest) {
 		return requeueWaitGSIReady
 	}
@@ -158,18 +473,88 @@ func (rm *resourceManager) syncTableGlobalSecondaryIndexes(
 	if err != nil {
 		return err
 	}
+	if len(input.GlobalSecondaryIndexUpdates) == 0 {
+		// Every queued action was overtaken by the observed state - e.g.
+		// the index a delete targeted is already gone, or a throughput
+		// change was applied by a previous call. Nothing left to send.
+		setGSIsSyncingCondition(desired, corev1.ConditionFalse, nil)
+		return nil
+	}
 
 	_, err = rm.sdkapi.UpdateTable(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateTable", err)
+	recordSDKCall("UpdateTable", err)
 	if err != nil {
 		return err
 	}
+	if summary := describeGSIUpdateActions(input, latest); summary != "" {
+		recordTableEvent(desired, "UpdatedGlobalSecondaryIndexes", summary)
+	}
+	// A deleted index leaves its contributor insights entry dangling
+	// server-side; turn insights off for the removed names so later
+	// per-index insights reads do not trip over them.
+	rm.disableContributorInsightsForDeletedGSIs(ctx, desired, observed, input)
 	if gsiInQueue > 0 {
+		msg := fmt.Sprintf("%d GSI update(s) still pending", gsiInQueue)
+		setGSIsSyncingCondition(desired, corev1.ConditionTrue, &msg)
 		return requeueWaitGSIReady
 	}
+	setGSIsSyncingCondition(desired, corev1.ConditionFalse, nil)
+	return nil
+}
+
+// validateGSIKeyAttributes returns a terminal error if a global secondary
+// index that is about to be created references a key attribute that is not
+// listed in the spec's attribute definitions; the UpdateTable call would be
+// rejected by DynamoDB anyway, so fail fast with a message naming the index
+// and the missing attribute.
+func validateGSIKeyAttributes(
+	addedGSIs []*v1alpha1.GlobalSecondaryIndex,
+	attributeDefinitions []*v1alpha1.AttributeDefinition,
+) error {
+	defined := map[string]struct{}{}
+	for _, ad := range attributeDefinitions {
+		if ad != nil && ad.AttributeName != nil {
+			defined[*ad.AttributeName] = struct{}{}
+		}
+	}
+	for _, gsi := range addedGSIs {
+		for _, ks := range gsi.KeySchema {
+			if ks == nil || ks.AttributeName == nil {
+				continue
+			}
+			if _, ok := defined[*ks.AttributeName]; !ok {
+				return ackerr.NewTerminalError(fmt.Errorf(
+					"global secondary index %q: key attribute %q is not listed in spec.attributeDefinitions",
+					*gsi.IndexName, *ks.AttributeName,
+				))
+			}
+		}
+	}
 	return nil
 }
 
+// gsiKeyAttributeDefinitions selects, from the spec's attribute definitions,
+// the ones referenced by the index's key schema.
+func gsiKeyAttributeDefinitions(
+	gsi *v1alpha1.GlobalSecondaryIndex,
+	attributeDefinitions []*v1alpha1.AttributeDefinition,
+) []*v1alpha1.AttributeDefinition {
+	var defs []*v1alpha1.AttributeDefinition
+	for _, ks := range gsi.KeySchema {
+		if ks == nil || ks.AttributeName == nil {
+			continue
+		}
+		for _, ad := range attributeDefinitions {
+			if ad != nil && ad.AttributeName != nil && *ad.AttributeName == *ks.AttributeName {
+				defs = append(defs, ad)
+				break
+			}
+		}
+	}
+	return defs
+}
+
 func (rm *resourceManager) newUpdateTableGlobalSecondaryIndexUpdatesPayload(
 	ctx context.Context,
 	latest *resource,
@@ -179,78 +564,182 @@ func (rm *resourceManager) newUpdateTableGlobalSecondaryIndexUpdatesPayload(
 		latest.ko.Spec.GlobalSecondaryIndexes,
 		desired.ko.Spec.GlobalSecondaryIndexes,
 	)
+	if err := validateGSIKeyAttributes(addedGSIs, desired.ko.Spec.AttributeDefinitions); err != nil {
+		return nil, 0, err
+	}
+	// Indexes on the table but absent from the spec are unmanaged under the
+	// retain policy; leave them in place instead of deleting them.
+	if len(removedGSIs) > 0 && retainUnmanagedGSIs(desired) {
+		removedGSIs = nil
+	}
 	input = &svcsdk.UpdateTableInput{
-		TableName:            aws.String(*latest.ko.Spec.TableName),
-		AttributeDefinitions: newSDKAttributesDefinition(desired.ko.Spec.AttributeDefinitions),
+		TableName: aws.String(*latest.ko.Spec.TableName),
 	}
 
-	// If we know that we're still gonna need to update another GSI we return a value gt 0.
-	gsisInQueue = len(addedGSIs) + len(updatedGSIs) + len(removedGSIs) - 1
+	totalOperations := len(addedGSIs) + len(updatedGSIs) + len(removedGSIs)
 
-	// Althought this sounds a little bit weird but it makes sense iterate on an array
-	// and directly return if we find an element. Range works well with nil arrays...
+	// On-demand tables have no provisioned throughput; leftover spec values
+	// must not be sent with index updates or DynamoDB rejects the call.
+	omitProvisionedThroughput := tableBillsPayPerRequest(desired, latest)
 
-	for _, addedGSI := range addedGSIs {
+	// DynamoDB only accepts a single GSI creation or deletion per UpdateTable
+	// call, so we issue one action per reconcile and requeue until the spec
+	// and the table converge. Deletes go out before creates so that a rename
+	// (delete+create reusing the same index name) does not collide.
+
+	for _, removedGSI := range removedGSIs {
 		update := svcsdktypes.GlobalSecondaryIndexUpdate{
-			Create: &svcsdktypes.CreateGlobalSecondaryIndexAction{
-				IndexName:             aws.String(*addedGSI.IndexName),
-				Projection:            newSDKProjection(addedGSI.Projection),
-				KeySchema:             newSDKKeySchemaArray(addedGSI.KeySchema),
-				ProvisionedThroughput: newSDKProvisionedThroughput(addedGSI.ProvisionedThroughput),
+			Delete: &svcsdktypes.DeleteGlobalSecondaryIndexAction{
+				IndexName: &removedGSI,
 			},
 		}
 		input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, update)
-		// We can only remove, update or add one GSI at once. Hence we return the update call input
-		// after we find the first added GSI.
-		return input, gsisInQueue, nil
+		// We can only remove or add one GSI at once. Hence we return the update call input
+		// after we find the first removed GSI.
+		return input, totalOperations - 1, nil
 	}
 
+	// A changed key schema or projection cannot be applied in place: the
+	// index has to be deleted now and recreated with the new definition on a
+	// later reconcile, once the delete has completed and the index no longer
+	// shows up on the table. The index is rebuilt from scratch and its data
+	// re-projected, so resources can opt out via the prevent-gsi-recreate
+	// annotation and go terminal instead.
 	for _, updatedGSI := range updatedGSIs {
+		var latestGSI *v1alpha1.GlobalSecondaryIndex
+		for _, gsi := range latest.ko.Spec.GlobalSecondaryIndexes {
+			if *gsi.IndexName == *updatedGSI.IndexName {
+				latestGSI = gsi
+				break
+			}
+		}
+		if latestGSI == nil || !gsiRequiresRecreate(updatedGSI, latestGSI) {
+			continue
+		}
+		if preventGSIRecreate(desired) {
+			return nil, 0, ackerr.NewTerminalError(fmt.Errorf(
+				"global secondary index %q: changing the key schema or projection requires "+
+					"deleting and recreating the index, which the %s annotation forbids",
+				*updatedGSI.IndexName, AnnotationPreventGSIRecreate,
+			))
+		}
+		msg := fmt.Sprintf(
+			"recreating global secondary index %q to apply key schema or projection "+
+				"changes; the index will be rebuilt and its data re-projected",
+			*updatedGSI.IndexName,
+		)
+		setGSIsSyncingCondition(desired, corev1.ConditionTrue, &msg)
 		update := svcsdktypes.GlobalSecondaryIndexUpdate{
-			Update: &svcsdktypes.UpdateGlobalSecondaryIndexAction{
-				IndexName:             aws.String(*updatedGSI.IndexName),
-				ProvisionedThroughput: newSDKProvisionedThroughput(updatedGSI.ProvisionedThroughput),
+			Delete: &svcsdktypes.DeleteGlobalSecondaryIndexAction{
+				IndexName: updatedGSI.IndexName,
 			},
 		}
 		input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, update)
-		// We can only remove, update or add one GSI at once. Hence we return the update call input
-		// after we find the first updated GSI.
-		return input, gsisInQueue, nil
+		// The delete is one action and the pending recreate another, so the
+		// queue keeps its size until the new index starts backfilling.
+		return input, totalOperations, nil
 	}
 
-	for _, removedGSI := range removedGSIs {
+	// Throughput-only updates of existing GSIs can all be batched into a
+	// single UpdateTable call; the one-at-a-time restriction only applies to
+	// index creations and deletions.
+	if len(updatedGSIs) > 0 {
+		// With the daily decrease budget exhausted, capacity decreases are
+		// parked until the budget resets - sending them would only burn a
+		// LimitExceededException. Increases are never delayed: they are
+		// split out and sent now while the decreases wait.
+		if throughputDecreaseBudgetExhausted(latest) {
+			var sendNow []*v1alpha1.GlobalSecondaryIndex
+			for _, updatedGSI := range updatedGSIs {
+				if gsiThroughputDecrease(updatedGSI, latest) {
+					continue
+				}
+				sendNow = append(sendNow, updatedGSI)
+			}
+			if len(sendNow) == 0 {
+				return nil, 0, requeueUntilDecreaseBudgetResets()
+			}
+			updatedGSIs = sendNow
+		}
+		for _, updatedGSI := range updatedGSIs {
+			var pt *svcsdktypes.ProvisionedThroughput
+			if !omitProvisionedThroughput {
+				pt, err = newSDKProvisionedThroughput(updatedGSI.ProvisionedThroughput)
+				if err != nil {
+					return nil, 0, fmt.Errorf("global secondary index %q: %w", *updatedGSI.IndexName, err)
+				}
+			}
+			update := svcsdktypes.GlobalSecondaryIndexUpdate{
+				Update: &svcsdktypes.UpdateGlobalSecondaryIndexAction{
+					IndexName:             aws.String(*updatedGSI.IndexName),
+					ProvisionedThroughput: pt,
+					OnDemandThroughput:    newSDKOnDemandThroughput(updatedGSI.OnDemandThroughput),
+					WarmThroughput:        newSDKWarmThroughput(updatedGSI.WarmThroughput),
+				},
+			}
+			input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, update)
+		}
+		return input, totalOperations - len(updatedGSIs), nil
+	}
+
+	for _, addedGSI := range addedGSIs {
+		// The call creating an index must carry the definitions for that
+		// index's key attributes: an index deleted out of band also takes
+		// its attribute definitions off the table, so they cannot be
+		// assumed present. Only the attributes this index uses are sent;
+		// definitions for indexes created on later reconciles would be
+		// rejected by DynamoDB as unused.
+		input.AttributeDefinitions = newSDKAttributesDefinition(
+			gsiKeyAttributeDefinitions(addedGSI, desired.ko.Spec.AttributeDefinitions),
+		)
+		var pt *svcsdktypes.ProvisionedThroughput
+		if !omitProvisionedThroughput {
+			pt, err = newSDKProvisionedThroughput(addedGSI.ProvisionedThroughput)
+			if err != nil {
+				return nil, 0, fmt.Errorf("global secondary index %q: %w", *addedGSI.IndexName, err)
+			}
+		}
 		update := svcsdktypes.GlobalSecondaryIndexUpdate{
-			Delete: &svcsdktypes.DeleteGlobalSecondaryIndexAction{
-				IndexName: &removedGSI,
+			Create: &svcsdktypes.CreateGlobalSecondaryIndexAction{
+				IndexName:             aws.String(*addedGSI.IndexName),
+				Projection:            newSDKProjection(addedGSI.Projection),
+				KeySchema:             newSDKKeySchemaArray(addedGSI.KeySchema),
+				ProvisionedThroughput: pt,
+				OnDemandThroughput:    newSDKOnDemandThroughput(addedGSI.OnDemandThroughput),
+				WarmThroughput:        newSDKWarmThroughput(addedGSI.WarmThroughput),
 			},
 		}
 		input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, update)
-		// We can only remove, update or add one GSI at once. Hence we return the update call input
-		// after we find the first removed GSI.
-		return input, gsisInQueue, nil
+		// We can only remove or add one GSI at once. Hence we return the update call input
+		// after we find the first added GSI.
+		return input, totalOperations - 1, nil
 	}
-	return input, gsisInQueue, nil
+	return input, 0, nil
 }
 
 // newSDKProvisionedThroughput builds a new *svcsdk.ProvisionedThroughput
-func newSDKProvisionedThroughput(pt *v1alpha1.ProvisionedThroughput) *svcsdktypes.ProvisionedThroughput {
+//
+// Capacity units are never defaulted: a missing value is surfaced as a
+// terminal error naming the field instead of silently provisioning 1
+// capacity unit.
+func newSDKProvisionedThroughput(pt *v1alpha1.ProvisionedThroughput) (*svcsdktypes.ProvisionedThroughput, error) {
 	if pt == nil {
-		return nil
+		return nil, nil
 	}
-	provisionedThroughput := &svcsdktypes.ProvisionedThroughput{
-		// ref: https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_ProvisionedThroughput.html
-		// Minimum capacity units is 1 when using provisioned capacity mode
-		ReadCapacityUnits:  aws.Int64(1),
-		WriteCapacityUnits: aws.Int64(1),
+	if pt.ReadCapacityUnits == nil {
+		return nil, ackerr.NewTerminalError(errors.New(
+			"provisionedThroughput.readCapacityUnits is required but not set",
+		))
 	}
-	if pt.ReadCapacityUnits != nil {
-		provisionedThroughput.ReadCapacityUnits = aws.Int64(*pt.ReadCapacityUnits)
-	}
-
-	if pt.WriteCapacityUnits != nil {
-		provisionedThroughput.WriteCapacityUnits = aws.Int64(*pt.WriteCapacityUnits)
+	if pt.WriteCapacityUnits == nil {
+		return nil, ackerr.NewTerminalError(errors.New(
+			"provisionedThroughput.writeCapacityUnits is required but not set",
+		))
 	}
-	return provisionedThroughput
+	return &svcsdktypes.ProvisionedThroughput{
+		ReadCapacityUnits:  aws.Int64(*pt.ReadCapacityUnits),
+		WriteCapacityUnits: aws.Int64(*pt.WriteCapacityUnits),
+	}, nil
 }
 
 // newSDKProjection builds a new *svcsdk.Projection
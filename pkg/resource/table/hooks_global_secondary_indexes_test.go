@@ -1,11 +1,16 @@
 package table
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/aws/aws-sdk-go/aws"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
 )
@@ -15,9 +20,10 @@ func Test_newSDKProvisionedThroughput(t *testing.T) {
 		pt *v1alpha1.ProvisionedThroughput
 	}
 	tests := []struct {
-		name string
-		args args
-		want *svcsdktypes.ProvisionedThroughput
+		name    string
+		args    args
+		want    *svcsdktypes.ProvisionedThroughput
+		wantErr bool
 	}{
 		{
 			name: "provisioned throughput is  nil",
@@ -34,10 +40,7 @@ func Test_newSDKProvisionedThroughput(t *testing.T) {
 					WriteCapacityUnits: aws.Int64(10),
 				},
 			},
-			want: &svcsdktypes.ProvisionedThroughput{
-				ReadCapacityUnits:  aws.Int64(1),
-				WriteCapacityUnits: aws.Int64(10),
-			},
+			wantErr: true,
 		},
 		{
 			name: "provisioned throughput is not nil, write capacity units is nil",
@@ -47,10 +50,7 @@ func Test_newSDKProvisionedThroughput(t *testing.T) {
 					WriteCapacityUnits: nil,
 				},
 			},
-			want: &svcsdktypes.ProvisionedThroughput{
-				ReadCapacityUnits:  aws.Int64(10),
-				WriteCapacityUnits: aws.Int64(1),
-			},
+			wantErr: true,
 		},
 		{
 			name: "provisioned throughput is not nil, write and read capacity units are not nil",
@@ -68,9 +68,894 @@ func Test_newSDKProvisionedThroughput(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := newSDKProvisionedThroughput(tt.args.pt); !reflect.DeepEqual(got, tt.want) {
+			got, err := newSDKProvisionedThroughput(tt.args.pt)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newSDKProvisionedThroughput() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var termErr *ackerr.TerminalError
+				if !errors.As(err, &termErr) {
+					t.Errorf("newSDKProvisionedThroughput() error = %v, want a terminal error", err)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("newSDKProvisionedThroughput() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func testGSI(name string, rcu, wcu int64) *v1alpha1.GlobalSecondaryIndex {
+	return &v1alpha1.GlobalSecondaryIndex{
+		IndexName: aws.String(name),
+		KeySchema: []*v1alpha1.KeySchemaElement{
+			{
+				AttributeName: aws.String("id"),
+				KeyType:       aws.String("HASH"),
+			},
+		},
+		Projection: &v1alpha1.Projection{
+			ProjectionType: aws.String("ALL"),
+		},
+		ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(rcu),
+			WriteCapacityUnits: aws.Int64(wcu),
+		},
+	}
+}
+
+func tableResourceWithGSIs(gsis ...*v1alpha1.GlobalSecondaryIndex) *resource {
+	return &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{
+			TableName: aws.String("table"),
+			AttributeDefinitions: []*v1alpha1.AttributeDefinition{
+				{AttributeName: aws.String("id"), AttributeType: aws.String("S")},
+			},
+			GlobalSecondaryIndexes: gsis,
+		},
+	}}
+}
+
+func Test_newUpdateTableGlobalSecondaryIndexUpdatesPayload(t *testing.T) {
+	rm := &resourceManager{}
+
+	t.Run("deletes are issued before creates", func(t *testing.T) {
+		latest := tableResourceWithGSIs(testGSI("old-index", 5, 5))
+		desired := tableResourceWithGSIs(testGSI("new-index", 5, 5))
+		input, gsisInQueue, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 1 {
+			t.Fatalf("expected exactly one GSI update, got %d", len(input.GlobalSecondaryIndexUpdates))
+		}
+		if input.GlobalSecondaryIndexUpdates[0].Delete == nil {
+			t.Errorf("expected a Delete action first, got %+v", input.GlobalSecondaryIndexUpdates[0])
+		}
+		if gsisInQueue != 1 {
+			t.Errorf("expected 1 GSI update in queue, got %d", gsisInQueue)
+		}
+	})
+
+	t.Run("throughput-only updates are batched in one call", func(t *testing.T) {
+		latest := tableResourceWithGSIs(testGSI("index1", 5, 5), testGSI("index2", 5, 5))
+		desired := tableResourceWithGSIs(testGSI("index1", 10, 10), testGSI("index2", 20, 20))
+		input, gsisInQueue, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 2 {
+			t.Fatalf("expected both GSI updates batched, got %d", len(input.GlobalSecondaryIndexUpdates))
+		}
+		for _, update := range input.GlobalSecondaryIndexUpdates {
+			if update.Update == nil {
+				t.Errorf("expected an Update action, got %+v", update)
+			}
+		}
+		if gsisInQueue != 0 {
+			t.Errorf("expected no GSI updates in queue, got %d", gsisInQueue)
+		}
+	})
+
+	t.Run("creates are issued one at a time", func(t *testing.T) {
+		latest := tableResourceWithGSIs()
+		desired := tableResourceWithGSIs(testGSI("index1", 5, 5), testGSI("index2", 5, 5))
+		input, gsisInQueue, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 1 {
+			t.Fatalf("expected exactly one GSI update, got %d", len(input.GlobalSecondaryIndexUpdates))
+		}
+		if input.GlobalSecondaryIndexUpdates[0].Create == nil {
+			t.Errorf("expected a Create action, got %+v", input.GlobalSecondaryIndexUpdates[0])
+		}
+		if gsisInQueue != 1 {
+			t.Errorf("expected 1 GSI update in queue, got %d", gsisInQueue)
+		}
+	})
+
+	t.Run("a table-only throughput change sends no index updates", func(t *testing.T) {
+		r := tableResourceWithGSIs(testGSI("index1", 5, 5), testGSI("index2", 5, 5))
+		r.ko.Spec.ProvisionedThroughput = &v1alpha1.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(10),
+			WriteCapacityUnits: aws.Int64(20),
+		}
+		input := newUpdateTableProvisionedThroughputPayload(r)
+		if got, want := *input.ProvisionedThroughput.ReadCapacityUnits, int64(10); got != want {
+			t.Errorf("ReadCapacityUnits = %d, want %d", got, want)
+		}
+		if got, want := *input.ProvisionedThroughput.WriteCapacityUnits, int64(20); got != want {
+			t.Errorf("WriteCapacityUnits = %d, want %d", got, want)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 0 {
+			t.Errorf("expected no index updates, got %+v", input.GlobalSecondaryIndexUpdates)
+		}
+	})
+
+	t.Run("a single-GSI change touches neither the table throughput nor other indexes", func(t *testing.T) {
+		latest := tableResourceWithGSIs(testGSI("index1", 5, 5), testGSI("index2", 5, 5))
+		desired := tableResourceWithGSIs(testGSI("index1", 5, 5), testGSI("index2", 5, 50))
+		input, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if input.ProvisionedThroughput != nil {
+			t.Errorf("expected no table-level throughput, got %+v", input.ProvisionedThroughput)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 1 {
+			t.Fatalf("expected exactly one index update, got %+v", input.GlobalSecondaryIndexUpdates)
+		}
+		update := input.GlobalSecondaryIndexUpdates[0].Update
+		if update == nil || *update.IndexName != "index2" {
+			t.Fatalf("expected an Update action for index2, got %+v", input.GlobalSecondaryIndexUpdates[0])
+		}
+		if got, want := *update.ProvisionedThroughput.WriteCapacityUnits, int64(50); got != want {
+			t.Errorf("WriteCapacityUnits = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("combined table and GSI changes stay in separate minimal inputs", func(t *testing.T) {
+		latest := tableResourceWithGSIs(testGSI("index1", 5, 5))
+		desired := tableResourceWithGSIs(testGSI("index1", 5, 50))
+		desired.ko.Spec.ProvisionedThroughput = &v1alpha1.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(10),
+			WriteCapacityUnits: aws.Int64(20),
+		}
+		tableInput := newUpdateTableProvisionedThroughputPayload(desired)
+		if len(tableInput.GlobalSecondaryIndexUpdates) != 0 {
+			t.Errorf("table input must not carry index updates, got %+v", tableInput.GlobalSecondaryIndexUpdates)
+		}
+		gsiInput, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if gsiInput.ProvisionedThroughput != nil {
+			t.Errorf("GSI input must not carry the table throughput, got %+v", gsiInput.ProvisionedThroughput)
+		}
+		if len(gsiInput.GlobalSecondaryIndexUpdates) != 1 || gsiInput.GlobalSecondaryIndexUpdates[0].Update == nil {
+			t.Fatalf("expected exactly one Update action, got %+v", gsiInput.GlobalSecondaryIndexUpdates)
+		}
+	})
+
+	t.Run("recreating an index deleted out of band sends its attribute definitions", func(t *testing.T) {
+		// Someone deleted the index from the console, which also removed its
+		// key attribute definition from the table; the server state reflects
+		// neither. The re-create call must carry both the Create action and
+		// the definition for the index's key attribute, but not definitions
+		// for indexes that do not exist yet.
+		latest := tableResourceWithGSIs()
+		gone := testGSI("gone-index", 5, 5)
+		gone.KeySchema[0].AttributeName = aws.String("gone-attr")
+		desired := tableResourceWithGSIs(gone, testGSI("other-index", 5, 5))
+		desired.ko.Spec.AttributeDefinitions = append(
+			desired.ko.Spec.AttributeDefinitions,
+			&v1alpha1.AttributeDefinition{
+				AttributeName: aws.String("gone-attr"),
+				AttributeType: aws.String("S"),
+			},
+		)
+		input, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 1 || input.GlobalSecondaryIndexUpdates[0].Create == nil {
+			t.Fatalf("expected exactly one Create action, got %+v", input.GlobalSecondaryIndexUpdates)
+		}
+		if got := *input.GlobalSecondaryIndexUpdates[0].Create.IndexName; got != "gone-index" {
+			t.Errorf("expected the Create action for %q, got %q", "gone-index", got)
+		}
+		if len(input.AttributeDefinitions) != 1 {
+			t.Fatalf("expected exactly the created index's attribute definition, got %+v", input.AttributeDefinitions)
+		}
+		if got := *input.AttributeDefinitions[0].AttributeName; got != "gone-attr" {
+			t.Errorf("expected attribute definition %q, got %q", "gone-attr", got)
+		}
+	})
+
+	t.Run("index deletions send no attribute definitions", func(t *testing.T) {
+		latest := tableResourceWithGSIs(testGSI("old-index", 5, 5))
+		desired := tableResourceWithGSIs()
+		input, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(input.AttributeDefinitions) != 0 {
+			t.Errorf("expected no attribute definitions on a delete-only call, got %+v", input.AttributeDefinitions)
+		}
+	})
+
+	t.Run("provisioned throughput is sent when the table is PROVISIONED", func(t *testing.T) {
+		latest := tableResourceWithGSIs()
+		desired := tableResourceWithGSIs(testGSI("index1", 5, 5))
+		desired.ko.Spec.BillingMode = aws.String("PROVISIONED")
+		input, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if input.GlobalSecondaryIndexUpdates[0].Create.ProvisionedThroughput == nil {
+			t.Error("expected the Create action to carry the provisioned throughput")
+		}
+	})
+
+	t.Run("provisioned throughput is omitted when the table is PAY_PER_REQUEST", func(t *testing.T) {
+		latest := tableResourceWithGSIs()
+		desired := tableResourceWithGSIs(testGSI("index1", 5, 5))
+		desired.ko.Spec.BillingMode = aws.String("PAY_PER_REQUEST")
+		input, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if pt := input.GlobalSecondaryIndexUpdates[0].Create.ProvisionedThroughput; pt != nil {
+			t.Errorf("expected no provisioned throughput on an on-demand table, got %+v", pt)
+		}
+	})
+
+	t.Run("observed billing mode is used when the spec does not set one", func(t *testing.T) {
+		latest := tableResourceWithGSIs(testGSI("index1", 0, 0))
+		latest.ko.Spec.BillingMode = aws.String("PAY_PER_REQUEST")
+		desired := tableResourceWithGSIs(testGSI("index1", 5, 5))
+		desired.ko.Spec.GlobalSecondaryIndexes[0].WarmThroughput = &v1alpha1.WarmThroughput{
+			ReadUnitsPerSecond: aws.Int64(12000),
+		}
+		input, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if pt := input.GlobalSecondaryIndexUpdates[0].Update.ProvisionedThroughput; pt != nil {
+			t.Errorf("expected no provisioned throughput on an on-demand table, got %+v", pt)
+		}
+	})
+
+	t.Run("key schema change deletes the index for recreation", func(t *testing.T) {
+		latest := tableResourceWithGSIs(testGSI("index1", 5, 5))
+		changed := testGSI("index1", 5, 5)
+		changed.KeySchema = append(changed.KeySchema, &v1alpha1.KeySchemaElement{
+			AttributeName: aws.String("id"),
+			KeyType:       aws.String("RANGE"),
+		})
+		desired := tableResourceWithGSIs(changed)
+		input, gsisInQueue, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 1 {
+			t.Fatalf("expected exactly one GSI update, got %d", len(input.GlobalSecondaryIndexUpdates))
+		}
+		if input.GlobalSecondaryIndexUpdates[0].Delete == nil {
+			t.Errorf("expected a Delete action, got %+v", input.GlobalSecondaryIndexUpdates[0])
+		}
+		if gsisInQueue != 1 {
+			t.Errorf("expected the pending recreate to stay in the queue, got %d", gsisInQueue)
+		}
+	})
+
+	t.Run("prevent-gsi-recreate annotation goes terminal instead", func(t *testing.T) {
+		latest := tableResourceWithGSIs(testGSI("index1", 5, 5))
+		changed := testGSI("index1", 5, 5)
+		changed.Projection = &v1alpha1.Projection{ProjectionType: aws.String("KEYS_ONLY")}
+		desired := tableResourceWithGSIs(changed)
+		desired.ko.ObjectMeta.Annotations = map[string]string{
+			AnnotationPreventGSIRecreate: "true",
+		}
+		_, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		var termErr *ackerr.TerminalError
+		if !errors.As(err, &termErr) {
+			t.Fatalf("expected a terminal error, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "index1") {
+			t.Errorf("terminal error %q does not name the index", err.Error())
+		}
+	})
+
+	// On-demand table helpers: GSIs on a PAY_PER_REQUEST table carry no
+	// provisioned throughput, only the optional request unit caps.
+	onDemandGSI := func(name string, maxWrite int64) *v1alpha1.GlobalSecondaryIndex {
+		gsi := testGSI(name, 0, 0)
+		gsi.ProvisionedThroughput = nil
+		gsi.OnDemandThroughput = &v1alpha1.OnDemandThroughput{
+			MaxWriteRequestUnits: aws.Int64(maxWrite),
+		}
+		return gsi
+	}
+	onDemandTable := func(gsis ...*v1alpha1.GlobalSecondaryIndex) *resource {
+		r := tableResourceWithGSIs(gsis...)
+		r.ko.Spec.BillingMode = aws.String("PAY_PER_REQUEST")
+		return r
+	}
+
+	t.Run("a GSI cap change sends only OnDemandThroughput", func(t *testing.T) {
+		latest := onDemandTable(onDemandGSI("index1", 100), onDemandGSI("index2", 100))
+		desired := onDemandTable(onDemandGSI("index1", 100), onDemandGSI("index2", 200))
+		input, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 1 {
+			t.Fatalf("expected exactly one index update, got %+v", input.GlobalSecondaryIndexUpdates)
+		}
+		update := input.GlobalSecondaryIndexUpdates[0].Update
+		if update == nil || *update.IndexName != "index2" {
+			t.Fatalf("expected an Update action for index2, got %+v", input.GlobalSecondaryIndexUpdates[0])
+		}
+		if update.ProvisionedThroughput != nil {
+			t.Errorf("on-demand index update must not carry ProvisionedThroughput, got %+v", update.ProvisionedThroughput)
+		}
+		if update.OnDemandThroughput == nil ||
+			*update.OnDemandThroughput.MaxWriteRequestUnits != 200 {
+			t.Errorf("OnDemandThroughput = %+v, want MaxWriteRequestUnits=200", update.OnDemandThroughput)
+		}
+	})
+
+	t.Run("a GSI warm throughput increase goes through the index's WarmThroughput", func(t *testing.T) {
+		latestGSI := testGSI("index1", 5, 5)
+		latestGSI.WarmThroughput = &v1alpha1.WarmThroughput{WriteUnitsPerSecond: aws.Int64(100)}
+		desiredGSI := testGSI("index1", 5, 5)
+		desiredGSI.WarmThroughput = &v1alpha1.WarmThroughput{WriteUnitsPerSecond: aws.Int64(500)}
+		input, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(
+			nil, tableResourceWithGSIs(latestGSI), tableResourceWithGSIs(desiredGSI))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 1 {
+			t.Fatalf("expected exactly one index update, got %+v", input.GlobalSecondaryIndexUpdates)
+		}
+		update := input.GlobalSecondaryIndexUpdates[0].Update
+		if update == nil || update.WarmThroughput == nil ||
+			*update.WarmThroughput.WriteUnitsPerSecond != 500 {
+			t.Errorf("expected an Update action carrying WarmThroughput 500, got %+v", update)
+		}
+	})
+
+	t.Run("a table-level cap change does not rewrite the indexes", func(t *testing.T) {
+		latest := onDemandTable(onDemandGSI("index1", 100))
+		desired := onDemandTable(onDemandGSI("index1", 100))
+		desired.ko.Spec.OnDemandThroughput = &v1alpha1.OnDemandThroughput{
+			MaxWriteRequestUnits: aws.Int64(1000),
+		}
+		input, gsisInQueue, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 0 || gsisInQueue != 0 {
+			t.Errorf("table cap change must not touch the indexes, got %+v", input.GlobalSecondaryIndexUpdates)
+		}
+	})
+}
+
+func Test_unmanagedGSIPolicy(t *testing.T) {
+	rm := &resourceManager{}
+
+	t.Run("by default an index absent from the spec is deleted", func(t *testing.T) {
+		latest := tableResourceWithGSIs(testGSI("managed", 5, 5), testGSI("unmanaged", 5, 5))
+		desired := tableResourceWithGSIs(testGSI("managed", 5, 5))
+		delta := &compare.Delta{}
+		customPreCompare(delta, desired, latest)
+		if !delta.DifferentAt("Spec.GlobalSecondaryIndexes") {
+			t.Fatal("expected a GSI diff for the undeclared index")
+		}
+		input, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 1 || input.GlobalSecondaryIndexUpdates[0].Delete == nil {
+			t.Fatalf("expected exactly one Delete action, got %+v", input.GlobalSecondaryIndexUpdates)
+		}
+		if got := *input.GlobalSecondaryIndexUpdates[0].Delete.IndexName; got != "unmanaged" {
+			t.Errorf("expected the Delete action for %q, got %q", "unmanaged", got)
+		}
+	})
+
+	t.Run("retain policy leaves undeclared indexes alone", func(t *testing.T) {
+		latest := tableResourceWithGSIs(testGSI("managed", 5, 5), testGSI("unmanaged", 5, 5))
+		desired := tableResourceWithGSIs(testGSI("managed", 5, 5))
+		desired.ko.ObjectMeta.Annotations = map[string]string{
+			AnnotationUnmanagedGSIPolicy: "retain",
+		}
+		delta := &compare.Delta{}
+		customPreCompare(delta, desired, latest)
+		if delta.DifferentAt("Spec.GlobalSecondaryIndexes") {
+			t.Fatalf("expected no GSI diff, got %+v", delta.Differences)
+		}
+		input, gsisInQueue, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 0 {
+			t.Errorf("expected no GSI updates, got %+v", input.GlobalSecondaryIndexUpdates)
+		}
+		if gsisInQueue != 0 {
+			t.Errorf("expected no GSI updates in queue, got %d", gsisInQueue)
+		}
+	})
+
+	t.Run("retain policy still reconciles declared indexes", func(t *testing.T) {
+		latest := tableResourceWithGSIs(testGSI("managed", 5, 5), testGSI("unmanaged", 5, 5))
+		desired := tableResourceWithGSIs(testGSI("managed", 10, 10), testGSI("new-index", 5, 5))
+		desired.ko.ObjectMeta.Annotations = map[string]string{
+			AnnotationUnmanagedGSIPolicy: "retain",
+		}
+		delta := &compare.Delta{}
+		customPreCompare(delta, desired, latest)
+		if !delta.DifferentAt("Spec.GlobalSecondaryIndexes") {
+			t.Fatal("expected a GSI diff for the declared indexes")
+		}
+		input, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, update := range input.GlobalSecondaryIndexUpdates {
+			if update.Delete != nil {
+				t.Errorf("expected no Delete action under the retain policy, got %+v", update)
+			}
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 1 || input.GlobalSecondaryIndexUpdates[0].Update == nil {
+			t.Fatalf("expected the throughput update for the managed index, got %+v", input.GlobalSecondaryIndexUpdates)
+		}
+		if got := *input.GlobalSecondaryIndexUpdates[0].Update.IndexName; got != "managed" {
+			t.Errorf("expected the Update action for %q, got %q", "managed", got)
+		}
+	})
+}
+
+func Test_gsiRequiresRecreate(t *testing.T) {
+	tests := []struct {
+		name         string
+		mutate       func(gsi *v1alpha1.GlobalSecondaryIndex)
+		mutateLatest func(gsi *v1alpha1.GlobalSecondaryIndex)
+		want         bool
+	}{
+		{
+			name:   "identical definition",
+			mutate: func(gsi *v1alpha1.GlobalSecondaryIndex) {},
+			want:   false,
+		},
+		{
+			name: "throughput-only change",
+			mutate: func(gsi *v1alpha1.GlobalSecondaryIndex) {
+				gsi.ProvisionedThroughput.ReadCapacityUnits = aws.Int64(50)
+			},
+			want: false,
+		},
+		{
+			name: "added sort key",
+			mutate: func(gsi *v1alpha1.GlobalSecondaryIndex) {
+				gsi.KeySchema = append(gsi.KeySchema, &v1alpha1.KeySchemaElement{
+					AttributeName: aws.String("created"),
+					KeyType:       aws.String("RANGE"),
+				})
+			},
+			want: true,
+		},
+		{
+			name: "changed key attribute",
+			mutate: func(gsi *v1alpha1.GlobalSecondaryIndex) {
+				gsi.KeySchema[0].AttributeName = aws.String("email")
+			},
+			want: true,
+		},
+		{
+			name: "changed projection type",
+			mutate: func(gsi *v1alpha1.GlobalSecondaryIndex) {
+				gsi.Projection.ProjectionType = aws.String("KEYS_ONLY")
+			},
+			want: true,
+		},
+		{
+			name: "changed non-key attributes",
+			mutate: func(gsi *v1alpha1.GlobalSecondaryIndex) {
+				gsi.Projection.ProjectionType = aws.String("INCLUDE")
+				gsi.Projection.NonKeyAttributes = []*string{aws.String("email")}
+			},
+			mutateLatest: func(gsi *v1alpha1.GlobalSecondaryIndex) {
+				gsi.Projection.ProjectionType = aws.String("INCLUDE")
+				gsi.Projection.NonKeyAttributes = []*string{aws.String("name")}
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			latest := testGSI("index1", 5, 5)
+			desired := testGSI("index1", 5, 5)
+			tt.mutate(desired)
+			if tt.mutateLatest != nil {
+				tt.mutateLatest(latest)
+			}
+			if got := gsiRequiresRecreate(desired, latest); got != tt.want {
+				t.Errorf("gsiRequiresRecreate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_equalGlobalSecondaryIndexesArrays_ordering(t *testing.T) {
+	gsi := func(name string, keys []*v1alpha1.KeySchemaElement, nonKey []*string) *v1alpha1.GlobalSecondaryIndex {
+		return &v1alpha1.GlobalSecondaryIndex{
+			IndexName: aws.String(name),
+			KeySchema: keys,
+			Projection: &v1alpha1.Projection{
+				ProjectionType:   aws.String("INCLUDE"),
+				NonKeyAttributes: nonKey,
+			},
+		}
+	}
+	keysAB := []*v1alpha1.KeySchemaElement{
+		{AttributeName: aws.String("a"), KeyType: aws.String("HASH")},
+		{AttributeName: aws.String("b"), KeyType: aws.String("RANGE")},
+	}
+	keysBA := []*v1alpha1.KeySchemaElement{
+		{AttributeName: aws.String("b"), KeyType: aws.String("RANGE")},
+		{AttributeName: aws.String("a"), KeyType: aws.String("HASH")},
+	}
+	tests := []struct {
+		name string
+		a    []*v1alpha1.GlobalSecondaryIndex
+		b    []*v1alpha1.GlobalSecondaryIndex
+		want bool
+	}{
+		{
+			name: "identical ordering",
+			a: []*v1alpha1.GlobalSecondaryIndex{
+				gsi("one", keysAB, []*string{aws.String("x"), aws.String("y")}),
+				gsi("two", keysAB, nil),
+			},
+			b: []*v1alpha1.GlobalSecondaryIndex{
+				gsi("one", keysAB, []*string{aws.String("x"), aws.String("y")}),
+				gsi("two", keysAB, nil),
+			},
+			want: true,
+		},
+		{
+			name: "shuffled indexes, key schema and non-key attributes",
+			a: []*v1alpha1.GlobalSecondaryIndex{
+				gsi("one", keysAB, []*string{aws.String("x"), aws.String("y")}),
+				gsi("two", keysAB, nil),
+			},
+			b: []*v1alpha1.GlobalSecondaryIndex{
+				gsi("two", keysBA, nil),
+				gsi("one", keysBA, []*string{aws.String("y"), aws.String("x")}),
+			},
+			want: true,
+		},
+		{
+			name: "different key type",
+			a: []*v1alpha1.GlobalSecondaryIndex{
+				gsi("one", keysAB, nil),
+			},
+			b: []*v1alpha1.GlobalSecondaryIndex{
+				gsi("one", []*v1alpha1.KeySchemaElement{
+					{AttributeName: aws.String("a"), KeyType: aws.String("RANGE")},
+					{AttributeName: aws.String("b"), KeyType: aws.String("HASH")},
+				}, nil),
+			},
+			want: false,
+		},
+		{
+			name: "different non-key attributes",
+			a: []*v1alpha1.GlobalSecondaryIndex{
+				gsi("one", keysAB, []*string{aws.String("x"), aws.String("y")}),
+			},
+			b: []*v1alpha1.GlobalSecondaryIndex{
+				gsi("one", keysAB, []*string{aws.String("x"), aws.String("z")}),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := equalGlobalSecondaryIndexesArrays(tt.a, tt.b); got != tt.want {
+				t.Errorf("equalGlobalSecondaryIndexesArrays() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_validateGSIKeyAttributes(t *testing.T) {
+	attrs := []*v1alpha1.AttributeDefinition{
+		{AttributeName: aws.String("id"), AttributeType: aws.String("S")},
+	}
+	if err := validateGSIKeyAttributes([]*v1alpha1.GlobalSecondaryIndex{testGSI("index1", 5, 5)}, attrs); err != nil {
+		t.Errorf("validateGSIKeyAttributes() error = %v, want nil", err)
+	}
+	missing := testGSI("index2", 5, 5)
+	missing.KeySchema = append(missing.KeySchema, &v1alpha1.KeySchemaElement{
+		AttributeName: aws.String("createdAt"),
+		KeyType:       aws.String("RANGE"),
+	})
+	err := validateGSIKeyAttributes([]*v1alpha1.GlobalSecondaryIndex{missing}, attrs)
+	var termErr *ackerr.TerminalError
+	if !errors.As(err, &termErr) {
+		t.Fatalf("validateGSIKeyAttributes() error = %v, want terminal error", err)
+	}
+}
+
+func Test_updateGSIsInSyncCondition(t *testing.T) {
+	gsiDescription := func(name, status string, backfilling bool, itemCount int64) *v1alpha1.GlobalSecondaryIndexDescription {
+		d := &v1alpha1.GlobalSecondaryIndexDescription{
+			IndexName:   aws.String(name),
+			IndexStatus: aws.String(status),
+		}
+		if backfilling {
+			d.Backfilling = aws.Bool(true)
+			d.ItemCount = aws.Int64(itemCount)
+		}
+		return d
+	}
+	tableWithGSIDescriptions := func(descriptions ...*v1alpha1.GlobalSecondaryIndexDescription) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Status: v1alpha1.TableStatus{
+				GlobalSecondaryIndexesDescriptions: descriptions,
+			},
+		}}
+	}
+
+	t.Run("multi-GSI backfill lists the pending indexes", func(t *testing.T) {
+		r := tableWithGSIDescriptions(
+			gsiDescription("index1", "CREATING", true, 1500),
+			gsiDescription("index2", "CREATING", false, 0),
+			gsiDescription("index3", "ACTIVE", false, 0),
+		)
+		updateGSIsInSyncCondition(r)
+		c := getConditionOfType(r, ConditionTypeGSIsInSync)
+		if c == nil {
+			t.Fatal("expected a GlobalSecondaryIndexesInSync condition")
+		}
+		if c.Status != corev1.ConditionFalse {
+			t.Errorf("condition status = %v, want False", c.Status)
+		}
+		if c.Message == nil {
+			t.Fatal("expected a condition message")
+		}
+		for _, want := range []string{"index1: CREATING (backfilling, 1500 items copied)", "index2: CREATING"} {
+			if !strings.Contains(*c.Message, want) {
+				t.Errorf("condition message %q does not contain %q", *c.Message, want)
+			}
+		}
+		if strings.Contains(*c.Message, "index3") {
+			t.Errorf("condition message %q should not mention the ACTIVE index", *c.Message)
+		}
+	})
+
+	t.Run("all indexes ACTIVE flips the condition to True", func(t *testing.T) {
+		r := tableWithGSIDescriptions(
+			gsiDescription("index1", "ACTIVE", false, 0),
+			gsiDescription("index2", "ACTIVE", false, 0),
+		)
+		updateGSIsInSyncCondition(r)
+		c := getConditionOfType(r, ConditionTypeGSIsInSync)
+		if c == nil {
+			t.Fatal("expected a GlobalSecondaryIndexesInSync condition")
+		}
+		if c.Status != corev1.ConditionTrue {
+			t.Errorf("condition status = %v, want True", c.Status)
+		}
+		if c.Message != nil {
+			t.Errorf("condition message = %q, want none", *c.Message)
+		}
+	})
+
+	t.Run("no GSIs is in sync", func(t *testing.T) {
+		r := tableWithGSIDescriptions()
+		updateGSIsInSyncCondition(r)
+		c := getConditionOfType(r, ConditionTypeGSIsInSync)
+		if c == nil || c.Status != corev1.ConditionTrue {
+			t.Errorf("expected condition True for a table without GSIs")
+		}
+	})
+}
+
+func Test_refreshedGSIView_dropsStaleActions(t *testing.T) {
+	rm := &resourceManager{}
+
+	gsiDescription := func(name string, rcu, wcu int64, status svcsdktypes.IndexStatus) svcsdktypes.GlobalSecondaryIndexDescription {
+		return svcsdktypes.GlobalSecondaryIndexDescription{
+			IndexName:   aws.String(name),
+			IndexStatus: status,
+			KeySchema: []svcsdktypes.KeySchemaElement{
+				{AttributeName: aws.String("id"), KeyType: svcsdktypes.KeyTypeHash},
+			},
+			Projection: &svcsdktypes.Projection{
+				ProjectionType: svcsdktypes.ProjectionTypeAll,
+			},
+			ProvisionedThroughput: &svcsdktypes.ProvisionedThroughputDescription{
+				ReadCapacityUnits:  aws.Int64(rcu),
+				WriteCapacityUnits: aws.Int64(wcu),
+			},
+		}
+	}
+
+	t.Run("actions overtaken between find and update are dropped", func(t *testing.T) {
+		// The reconcile-start observation still shows index1 at the old
+		// throughput and index2 present, so the delta queued an update and
+		// a delete. By the time the mutation slot comes around the service
+		// has already applied both.
+		stale := tableResourceWithGSIs(testGSI("index1", 5, 5), testGSI("index2", 5, 5))
+		desired := tableResourceWithGSIs(testGSI("index1", 10, 10))
+		table := &svcsdktypes.TableDescription{
+			GlobalSecondaryIndexes: []svcsdktypes.GlobalSecondaryIndexDescription{
+				gsiDescription("index1", 10, 10, svcsdktypes.IndexStatusActive),
+			},
+		}
+		fresh := refreshedGSIView(stale, table)
+		input, gsisInQueue, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, fresh, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 0 {
+			t.Errorf("expected no index updates, got %+v", input.GlobalSecondaryIndexUpdates)
+		}
+		if gsisInQueue != 0 {
+			t.Errorf("expected no GSI updates in queue, got %d", gsisInQueue)
+		}
+	})
+
+	t.Run("actions still pending survive the refresh", func(t *testing.T) {
+		stale := tableResourceWithGSIs(testGSI("index1", 5, 5))
+		desired := tableResourceWithGSIs(testGSI("index1", 10, 10))
+		table := &svcsdktypes.TableDescription{
+			GlobalSecondaryIndexes: []svcsdktypes.GlobalSecondaryIndexDescription{
+				gsiDescription("index1", 5, 5, svcsdktypes.IndexStatusActive),
+			},
+		}
+		fresh := refreshedGSIView(stale, table)
+		input, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, fresh, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 1 || input.GlobalSecondaryIndexUpdates[0].Update == nil {
+			t.Fatalf("expected exactly one Update action, got %+v", input.GlobalSecondaryIndexUpdates)
+		}
+	})
+
+	t.Run("indexes flipped to UPDATING by a replica removal defer the mutation", func(t *testing.T) {
+		stale := tableResourceWithGSIs(testGSI("index1", 5, 5))
+		table := &svcsdktypes.TableDescription{
+			GlobalSecondaryIndexes: []svcsdktypes.GlobalSecondaryIndexDescription{
+				gsiDescription("index1", 5, 5, svcsdktypes.IndexStatusUpdating),
+			},
+		}
+		fresh := refreshedGSIView(stale, table)
+		if canUpdateTableGSIs(fresh) {
+			t.Error("expected canUpdateTableGSIs to be false while an index is UPDATING")
+		}
+	})
+}
+
+func Test_gsiDescriptionsFromSDK(t *testing.T) {
+	t.Run("full description is kept, including the index ARN", func(t *testing.T) {
+		// The shape CreateTable returns for a fresh table with one GSI: the
+		// index is still CREATING but its ARN is already assigned, so
+		// FieldExport paths like globalSecondaryIndexesDescriptions[0]
+		// .indexARN resolve on the first post-create reconcile.
+		got := gsiDescriptionsFromSDK([]svcsdktypes.GlobalSecondaryIndexDescription{
+			{
+				IndexName:   aws.String("email-index"),
+				IndexArn:    aws.String("arn:aws:dynamodb:us-west-2:123456789012:table/table/index/email-index"),
+				IndexStatus: svcsdktypes.IndexStatusCreating,
+				Backfilling: aws.Bool(true),
+				ItemCount:   aws.Int64(0),
+			},
+		})
+		if len(got) != 1 {
+			t.Fatalf("expected one description, got %d", len(got))
+		}
+		d := got[0]
+		if d.IndexARN == nil || *d.IndexARN == "" {
+			t.Error("expected a non-empty IndexARN")
+		}
+		if d.IndexName == nil || *d.IndexName != "email-index" {
+			t.Errorf("IndexName = %v, want email-index", d.IndexName)
+		}
+		if d.IndexStatus == nil || *d.IndexStatus != "CREATING" {
+			t.Errorf("IndexStatus = %v, want CREATING", d.IndexStatus)
+		}
+		if d.Backfilling == nil || !*d.Backfilling {
+			t.Error("expected Backfilling to be true")
+		}
+	})
+
+	t.Run("a table without indexes yields nil", func(t *testing.T) {
+		if got := gsiDescriptionsFromSDK(nil); got != nil {
+			t.Errorf("expected nil, got %+v", got)
+		}
+	})
+}
+
+func Test_gsiThroughputOnlyUpdates(t *testing.T) {
+	t.Run("pure throughput updates ride along", func(t *testing.T) {
+		latest := tableResourceWithGSIs(testGSI("index1", 5, 5), testGSI("index2", 5, 5))
+		desired := tableResourceWithGSIs(testGSI("index1", 10, 10), testGSI("index2", 2, 2))
+		updates, ok := gsiThroughputOnlyUpdates(latest, desired)
+		if !ok || len(updates) != 2 {
+			t.Fatalf("gsiThroughputOnlyUpdates() = %v, %v, want both indexes and true", updates, ok)
+		}
+	})
+
+	t.Run("an index creation disqualifies the batch", func(t *testing.T) {
+		latest := tableResourceWithGSIs(testGSI("index1", 5, 5))
+		desired := tableResourceWithGSIs(testGSI("index1", 10, 10), testGSI("index2", 5, 5))
+		if _, ok := gsiThroughputOnlyUpdates(latest, desired); ok {
+			t.Error("expected false while an index creation is pending")
+		}
+	})
+
+	t.Run("a projection change disqualifies the batch", func(t *testing.T) {
+		latest := tableResourceWithGSIs(testGSI("index1", 5, 5))
+		changed := testGSI("index1", 5, 5)
+		changed.Projection.ProjectionType = aws.String("KEYS_ONLY")
+		desired := tableResourceWithGSIs(changed)
+		if _, ok := gsiThroughputOnlyUpdates(latest, desired); ok {
+			t.Error("expected false while an index recreate is pending")
+		}
+	})
+}
+
+func Test_gsiThroughputDecreaseBudget(t *testing.T) {
+	rm := &resourceManager{}
+	exhausted := func(latest *resource) *resource {
+		latest.ko.Status.ProvisionedThroughputDescription = &v1alpha1.ProvisionedThroughputDescription{
+			NumberOfDecreasesToday: aws.Int64(maxThroughputDecreasesPerDay),
+		}
+		return latest
+	}
+
+	t.Run("all-decrease batch is parked until the budget resets", func(t *testing.T) {
+		latest := exhausted(tableResourceWithGSIs(testGSI("index1", 10, 10)))
+		desired := tableResourceWithGSIs(testGSI("index1", 5, 5))
+		_, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if !errors.Is(err, ErrThroughputDecreaseBudget) {
+			t.Fatalf("error = %v, want ErrThroughputDecreaseBudget", err)
+		}
+	})
+
+	t.Run("increases are sent even with the budget exhausted", func(t *testing.T) {
+		latest := exhausted(tableResourceWithGSIs(testGSI("index1", 10, 10), testGSI("index2", 5, 5)))
+		desired := tableResourceWithGSIs(testGSI("index1", 5, 5), testGSI("index2", 20, 20))
+		input, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 1 {
+			t.Fatalf("expected only the increase to be sent, got %+v", input.GlobalSecondaryIndexUpdates)
+		}
+		update := input.GlobalSecondaryIndexUpdates[0].Update
+		if update == nil || *update.IndexName != "index2" {
+			t.Fatalf("expected an Update action for index2, got %+v", input.GlobalSecondaryIndexUpdates[0])
+		}
+	})
+
+	t.Run("below the budget all updates are batched", func(t *testing.T) {
+		latest := tableResourceWithGSIs(testGSI("index1", 10, 10), testGSI("index2", 5, 5))
+		desired := tableResourceWithGSIs(testGSI("index1", 5, 5), testGSI("index2", 20, 20))
+		input, _, err := rm.newUpdateTableGlobalSecondaryIndexUpdatesPayload(nil, latest, desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(input.GlobalSecondaryIndexUpdates) != 2 {
+			t.Fatalf("expected both updates batched, got %+v", input.GlobalSecondaryIndexUpdates)
+		}
+	})
+}
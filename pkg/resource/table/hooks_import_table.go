@@ -0,0 +1,259 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+var (
+	ErrTableImportInProgress = errors.New(
+		"table import in progress, cannot be modified or deleted",
+	)
+	requeueWaitImportInProgress = ackrequeue.NeededAfter(
+		ErrTableImportInProgress,
+		15*time.Second,
+	)
+)
+
+// newImportTableInput builds an ImportTableInput from the resource's spec,
+// deriving the table creation parameters from the same spec fields CreateTable
+// would use.
+func newImportTableInput(r *resource) (*svcsdk.ImportTableInput, error) {
+	importSpec := r.ko.Spec.ImportSourceSpecification
+	input := &svcsdk.ImportTableInput{}
+	if importSpec.InputFormat != nil {
+		input.InputFormat = svcsdktypes.InputFormat(*importSpec.InputFormat)
+	}
+	if importSpec.InputCompressionType != nil {
+		input.InputCompressionType = svcsdktypes.InputCompressionType(*importSpec.InputCompressionType)
+	}
+	if importSpec.InputFormatOptions != nil && importSpec.InputFormatOptions.CSV != nil {
+		input.InputFormatOptions = &svcsdktypes.InputFormatOptions{
+			Csv: &svcsdktypes.CsvOptions{
+				Delimiter:  importSpec.InputFormatOptions.CSV.Delimiter,
+				HeaderList: aws.ToStringSlice(importSpec.InputFormatOptions.CSV.HeaderList),
+			},
+		}
+	}
+	if importSpec.S3BucketSource != nil {
+		input.S3BucketSource = &svcsdktypes.S3BucketSource{
+			S3Bucket:      importSpec.S3BucketSource.S3Bucket,
+			S3BucketOwner: importSpec.S3BucketSource.S3BucketOwner,
+			S3KeyPrefix:   importSpec.S3BucketSource.S3KeyPrefix,
+		}
+	}
+
+	creationParams := &svcsdktypes.TableCreationParameters{
+		TableName:            r.ko.Spec.TableName,
+		AttributeDefinitions: newSDKAttributesDefinition(r.ko.Spec.AttributeDefinitions),
+		KeySchema:            newSDKKeySchemaArray(r.ko.Spec.KeySchema),
+	}
+	if r.ko.Spec.BillingMode != nil {
+		creationParams.BillingMode = svcsdktypes.BillingMode(*r.ko.Spec.BillingMode)
+	}
+	pt, err := newSDKProvisionedThroughput(r.ko.Spec.ProvisionedThroughput)
+	if err != nil {
+		return nil, err
+	}
+	creationParams.ProvisionedThroughput = pt
+	creationParams.OnDemandThroughput = newSDKOnDemandThroughput(r.ko.Spec.OnDemandThroughput)
+	if sse := r.ko.Spec.SSESpecification; sse != nil {
+		sseSpec := &svcsdktypes.SSESpecification{
+			Enabled:        sse.Enabled,
+			KMSMasterKeyId: sse.KMSMasterKeyID,
+		}
+		if sse.SSEType != nil {
+			sseSpec.SSEType = svcsdktypes.SSEType(*sse.SSEType)
+		}
+		creationParams.SSESpecification = sseSpec
+	}
+	for _, gsi := range r.ko.Spec.GlobalSecondaryIndexes {
+		gsiPT, err := newSDKProvisionedThroughput(gsi.ProvisionedThroughput)
+		if err != nil {
+			return nil, fmt.Errorf("global secondary index %q: %w", *gsi.IndexName, err)
+		}
+		creationParams.GlobalSecondaryIndexes = append(
+			creationParams.GlobalSecondaryIndexes,
+			svcsdktypes.GlobalSecondaryIndex{
+				IndexName:             gsi.IndexName,
+				KeySchema:             newSDKKeySchemaArray(gsi.KeySchema),
+				Projection:            newSDKProjection(gsi.Projection),
+				ProvisionedThroughput: gsiPT,
+				OnDemandThroughput:    newSDKOnDemandThroughput(gsi.OnDemandThroughput),
+				WarmThroughput:        newSDKWarmThroughput(gsi.WarmThroughput),
+			},
+		)
+	}
+	input.TableCreationParameters = creationParams
+	return input, nil
+}
+
+// setImportDescription copies the latest import description returned by the
+// API into Status.Import.
+func setImportDescription(
+	ko *v1alpha1.Table,
+	desc *svcsdktypes.ImportTableDescription,
+) {
+	if desc == nil {
+		return
+	}
+	imp := &v1alpha1.ImportTableDescription{
+		ErrorCount:         aws.Int64(desc.ErrorCount),
+		FailureCode:        desc.FailureCode,
+		FailureMessage:     desc.FailureMessage,
+		ImportARN:          desc.ImportArn,
+		ImportedItemCount:  aws.Int64(desc.ImportedItemCount),
+		ProcessedItemCount: aws.Int64(desc.ProcessedItemCount),
+		ProcessedSizeBytes: desc.ProcessedSizeBytes,
+		TableARN:           desc.TableArn,
+		TableID:            desc.TableId,
+	}
+	if desc.ImportStatus != "" {
+		imp.ImportStatus = aws.String(string(desc.ImportStatus))
+	}
+	if desc.InputCompressionType != "" {
+		imp.InputCompressionType = aws.String(string(desc.InputCompressionType))
+	}
+	if desc.InputFormat != "" {
+		imp.InputFormat = aws.String(string(desc.InputFormat))
+	}
+	if desc.InputFormatOptions != nil && desc.InputFormatOptions.Csv != nil {
+		imp.InputFormatOptions = &v1alpha1.InputFormatOptions{
+			CSV: &v1alpha1.CSVOptions{
+				Delimiter:  desc.InputFormatOptions.Csv.Delimiter,
+				HeaderList: aws.StringSlice(desc.InputFormatOptions.Csv.HeaderList),
+			},
+		}
+	}
+	if desc.S3BucketSource != nil {
+		imp.S3BucketSource = &v1alpha1.S3BucketSource{
+			S3Bucket:      desc.S3BucketSource.S3Bucket,
+			S3BucketOwner: desc.S3BucketSource.S3BucketOwner,
+			S3KeyPrefix:   desc.S3BucketSource.S3KeyPrefix,
+		}
+	}
+	if desc.StartTime != nil {
+		imp.StartTime = &metav1.Time{*desc.StartTime}
+	}
+	if desc.EndTime != nil {
+		imp.EndTime = &metav1.Time{*desc.EndTime}
+	}
+	ko.Status.Import = imp
+}
+
+// syncImportStatus refreshes Status.Import from DescribeImport. A still
+// running import returns a requeue error so the reconcile is retried instead
+// of timing out, and a FAILED import is surfaced as a terminal condition
+// carrying the failure code and message.
+func (rm *resourceManager) syncImportStatus(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncImportStatus")
+	defer func(err error) { exit(err) }(err)
+
+	imp := r.ko.Status.Import
+	if imp == nil || imp.ImportARN == nil {
+		return nil
+	}
+	resp, err := rm.sdkapi.DescribeImport(
+		ctx,
+		&svcsdk.DescribeImportInput{
+			ImportArn: imp.ImportARN,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeImport", err)
+	recordSDKCall("DescribeImport", err)
+	if err != nil {
+		return err
+	}
+	setImportDescription(r.ko, resp.ImportTableDescription)
+
+	switch resp.ImportTableDescription.ImportStatus {
+	case svcsdktypes.ImportStatusCompleted,
+		svcsdktypes.ImportStatusCancelled:
+		return nil
+	case svcsdktypes.ImportStatusFailed:
+		msg := fmt.Sprintf(
+			"table import failed: %s: %s",
+			aws.ToString(resp.ImportTableDescription.FailureCode),
+			aws.ToString(resp.ImportTableDescription.FailureMessage),
+		)
+		setTerminalCondition(r, corev1.ConditionTrue, &msg, nil)
+		return ackerr.NewTerminalError(errors.New(msg))
+	default:
+		msg := "table import in progress"
+		setSyncedCondition(r, corev1.ConditionFalse, &msg, nil)
+		return requeueWaitImportInProgress
+	}
+}
+
+// importTable creates the table from the S3 data referenced by
+// Spec.ImportSourceSpecification instead of calling CreateTable. The import
+// runs asynchronously and the table does not exist until the import has
+// created it, so sdkCreate can be reached again while the import is still
+// running; in that case the import recorded in Status.Import is polled
+// instead of starting a second one.
+func (rm *resourceManager) importTable(
+	ctx context.Context,
+	desired *resource,
+) (created *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.importTable")
+	defer func(err error) { exit(err) }(err)
+
+	if imp := desired.ko.Status.Import; imp != nil && imp.ImportARN != nil {
+		r := &resource{desired.ko.DeepCopy()}
+		if err := rm.syncImportStatus(ctx, r); err != nil {
+			return r, err
+		}
+		return r, nil
+	}
+
+	input, err := newImportTableInput(desired)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := rm.sdkapi.ImportTable(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "ImportTable", err)
+	recordSDKCall("ImportTable", err)
+	if err != nil {
+		return nil, err
+	}
+
+	ko := desired.ko.DeepCopy()
+	setImportDescription(ko, resp.ImportTableDescription)
+	rm.setStatusDefaults(ko)
+
+	r := &resource{ko}
+	msg := "table import in progress"
+	setSyncedCondition(r, corev1.ConditionFalse, &msg, nil)
+	return r, nil
+}
@@ -0,0 +1,92 @@
+package table
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+func Test_newImportTableInput(t *testing.T) {
+	r := &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{
+			TableName: aws.String("table"),
+			AttributeDefinitions: []*v1alpha1.AttributeDefinition{
+				{AttributeName: aws.String("id"), AttributeType: aws.String("S")},
+			},
+			KeySchema: []*v1alpha1.KeySchemaElement{
+				{AttributeName: aws.String("id"), KeyType: aws.String("HASH")},
+			},
+			BillingMode: aws.String("PAY_PER_REQUEST"),
+			ImportSourceSpecification: &v1alpha1.ImportSourceSpecification{
+				InputFormat:          aws.String("CSV"),
+				InputCompressionType: aws.String("GZIP"),
+				InputFormatOptions: &v1alpha1.InputFormatOptions{
+					CSV: &v1alpha1.CSVOptions{
+						Delimiter:  aws.String(","),
+						HeaderList: []*string{aws.String("id"), aws.String("value")},
+					},
+				},
+				S3BucketSource: &v1alpha1.S3BucketSource{
+					S3Bucket:    aws.String("import-bucket"),
+					S3KeyPrefix: aws.String("exports/table/"),
+				},
+			},
+		},
+	}}
+	input, err := newImportTableInput(r)
+	if err != nil {
+		t.Fatalf("newImportTableInput() error = %v", err)
+	}
+	if input.InputFormat != svcsdktypes.InputFormatCsv {
+		t.Errorf("newImportTableInput() input format = %v", input.InputFormat)
+	}
+	if input.InputCompressionType != svcsdktypes.InputCompressionTypeGzip {
+		t.Errorf("newImportTableInput() compression = %v", input.InputCompressionType)
+	}
+	if input.InputFormatOptions == nil || input.InputFormatOptions.Csv == nil ||
+		!reflect.DeepEqual(input.InputFormatOptions.Csv.HeaderList, []string{"id", "value"}) {
+		t.Errorf("newImportTableInput() CSV options = %v", input.InputFormatOptions)
+	}
+	if input.S3BucketSource == nil || *input.S3BucketSource.S3Bucket != "import-bucket" {
+		t.Errorf("newImportTableInput() S3 source = %v", input.S3BucketSource)
+	}
+	params := input.TableCreationParameters
+	if params == nil || *params.TableName != "table" {
+		t.Fatalf("newImportTableInput() creation parameters = %v", params)
+	}
+	if params.BillingMode != svcsdktypes.BillingModePayPerRequest {
+		t.Errorf("newImportTableInput() billing mode = %v", params.BillingMode)
+	}
+	if len(params.AttributeDefinitions) != 1 || len(params.KeySchema) != 1 {
+		t.Errorf("newImportTableInput() attributes = %v, key schema = %v",
+			params.AttributeDefinitions, params.KeySchema)
+	}
+}
+
+func Test_setImportDescription(t *testing.T) {
+	ko := &v1alpha1.Table{}
+	setImportDescription(ko, &svcsdktypes.ImportTableDescription{
+		ImportArn:          aws.String("arn:aws:dynamodb:us-west-2:123456789012:table/table/import/i1"),
+		ImportStatus:       svcsdktypes.ImportStatusFailed,
+		ProcessedItemCount: 10,
+		ImportedItemCount:  7,
+		ErrorCount:         3,
+		FailureCode:        aws.String("ImportValidationError"),
+		FailureMessage:     aws.String("row 8 is malformed"),
+	})
+	imp := ko.Status.Import
+	if imp == nil {
+		t.Fatal("setImportDescription() did not populate Status.Import")
+	}
+	if *imp.ImportStatus != "FAILED" || *imp.ProcessedItemCount != 10 ||
+		*imp.ImportedItemCount != 7 || *imp.ErrorCount != 3 {
+		t.Errorf("setImportDescription() import = %+v", imp)
+	}
+	if *imp.FailureCode != "ImportValidationError" || *imp.FailureMessage != "row 8 is malformed" {
+		t.Errorf("setImportDescription() failure = %v/%v", imp.FailureCode, imp.FailureMessage)
+	}
+}
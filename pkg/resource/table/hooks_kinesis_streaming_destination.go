@@ -0,0 +1,290 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+var (
+	ErrKinesisDestinationsUpdating = fmt.Errorf(
+		"kinesis streaming destination in '%v' state, cannot be modified or deleted",
+		svcsdktypes.DestinationStatusEnabling,
+	)
+	requeueWaitKinesisDestinationActive = ackrequeue.NeededAfter(
+		ErrKinesisDestinationsUpdating,
+		10*time.Second,
+	)
+)
+
+// setKinesisStreamingDestinations populates the latest view of the table's
+// Kinesis Data Streams destinations. Destinations that are disabled, or on
+// their way to being disabled, are left out so that the spec comparison only
+// sees destinations that are effectively attached to the table.
+func (rm *resourceManager) setKinesisStreamingDestinations(
+	ctx context.Context,
+	ko *v1alpha1.Table,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.setKinesisStreamingDestinations")
+	defer func(err error) { exit(err) }(err)
+
+	resp, err := rm.sdkapi.DescribeKinesisStreamingDestination(
+		ctx,
+		&svcsdk.DescribeKinesisStreamingDestinationInput{
+			TableName: ko.Spec.TableName,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeKinesisStreamingDestination", err)
+	recordSDKCall("DescribeKinesisStreamingDestination", err)
+	if err != nil {
+		return err
+	}
+
+	destinations := []*v1alpha1.KinesisDataStreamDestination{}
+	for _, destination := range resp.KinesisDataStreamDestinations {
+		switch destination.DestinationStatus {
+		case svcsdktypes.DestinationStatusDisabling, svcsdktypes.DestinationStatusDisabled:
+			continue
+		}
+		d := &v1alpha1.KinesisDataStreamDestination{
+			StreamARN:         destination.StreamArn,
+			DestinationStatus: aws.String(string(destination.DestinationStatus)),
+		}
+		if destination.ApproximateCreationDateTimePrecision != "" {
+			d.ApproximateCreationDateTimePrecision = aws.String(
+				string(destination.ApproximateCreationDateTimePrecision),
+			)
+		}
+		destinations = append(destinations, d)
+	}
+	if len(destinations) == 0 {
+		ko.Spec.KinesisStreamDestinations = nil
+		return nil
+	}
+	ko.Spec.KinesisStreamDestinations = destinations
+	return nil
+}
+
+// validateKinesisStreamDestinations returns a terminal error when a
+// destination sets a precision other than the values the API accepts, so a
+// spec that can never be applied surfaces immediately instead of retrying a
+// ValidationException.
+func validateKinesisStreamDestinations(r *resource) error {
+	for _, destination := range r.ko.Spec.KinesisStreamDestinations {
+		if destination.ApproximateCreationDateTimePrecision == nil {
+			continue
+		}
+		switch svcsdktypes.ApproximateCreationDateTimePrecision(*destination.ApproximateCreationDateTimePrecision) {
+		case svcsdktypes.ApproximateCreationDateTimePrecisionMillisecond,
+			svcsdktypes.ApproximateCreationDateTimePrecisionMicrosecond:
+		default:
+			return ackerr.NewTerminalError(fmt.Errorf(
+				"kinesisStreamDestinations approximateCreationDateTimePrecision must be %s or %s, got %q",
+				svcsdktypes.ApproximateCreationDateTimePrecisionMillisecond,
+				svcsdktypes.ApproximateCreationDateTimePrecisionMicrosecond,
+				*destination.ApproximateCreationDateTimePrecision,
+			))
+		}
+	}
+	return nil
+}
+
+// kinesisDestinationsTransitioning returns true if any of the table's Kinesis
+// streaming destinations is still being enabled, updated or disabled.
+func kinesisDestinationsTransitioning(r *resource) bool {
+	for _, destination := range r.ko.Spec.KinesisStreamDestinations {
+		if destination.DestinationStatus == nil {
+			continue
+		}
+		switch *destination.DestinationStatus {
+		case string(svcsdktypes.DestinationStatusEnabling),
+			string(svcsdktypes.DestinationStatusDisabling),
+			string(svcsdktypes.DestinationStatusUpdating):
+			return true
+		}
+	}
+	return false
+}
+
+// equalKinesisStreamDestinations returns true if two Kinesis streaming
+// destination arrays reference the same streams with the same precision. A
+// nil precision in the desired spec means the precision is not managed.
+func equalKinesisStreamDestinations(
+	a []*v1alpha1.KinesisDataStreamDestination,
+	b []*v1alpha1.KinesisDataStreamDestination,
+) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, aDestination := range a {
+		found := false
+		for _, bDestination := range b {
+			if aws.ToString(aDestination.StreamARN) != aws.ToString(bDestination.StreamARN) {
+				continue
+			}
+			found = aDestination.ApproximateCreationDateTimePrecision == nil ||
+				equalStrings(
+					aDestination.ApproximateCreationDateTimePrecision,
+					bDestination.ApproximateCreationDateTimePrecision,
+				)
+			break
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// computeKinesisStreamDestinationsDelta returns the destinations that need to
+// be enabled, updated or disabled to get from latest to desired.
+func computeKinesisStreamDestinationsDelta(
+	desired []*v1alpha1.KinesisDataStreamDestination,
+	latest []*v1alpha1.KinesisDataStreamDestination,
+) (added []*v1alpha1.KinesisDataStreamDestination, updated []*v1alpha1.KinesisDataStreamDestination, removed []*v1alpha1.KinesisDataStreamDestination) {
+	for _, latestDestination := range latest {
+		found := false
+		for _, desiredDestination := range desired {
+			if aws.ToString(desiredDestination.StreamARN) == aws.ToString(latestDestination.StreamARN) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			removed = append(removed, latestDestination)
+		}
+	}
+	for _, desiredDestination := range desired {
+		found := false
+		for _, latestDestination := range latest {
+			if aws.ToString(desiredDestination.StreamARN) != aws.ToString(latestDestination.StreamARN) {
+				continue
+			}
+			found = true
+			if desiredDestination.ApproximateCreationDateTimePrecision != nil &&
+				!equalStrings(
+					desiredDestination.ApproximateCreationDateTimePrecision,
+					latestDestination.ApproximateCreationDateTimePrecision,
+				) {
+				updated = append(updated, desiredDestination)
+			}
+			break
+		}
+		if !found {
+			added = append(added, desiredDestination)
+		}
+	}
+	return added, updated, removed
+}
+
+// syncKinesisStreamingDestinations reconciles the table's Kinesis streaming
+// destinations. Enable and disable are both asynchronous, so any destination
+// still transitioning makes the call requeue. Disables go out before enables
+// so that swapping the stream ARN detaches the old destination first.
+func (rm *resourceManager) syncKinesisStreamingDestinations(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncKinesisStreamingDestinations")
+	defer func(err error) { exit(err) }(err)
+
+	if err := validateKinesisStreamDestinations(desired); err != nil {
+		return err
+	}
+	if kinesisDestinationsTransitioning(latest) {
+		return requeueWaitKinesisDestinationActive
+	}
+
+	added, updated, removed := computeKinesisStreamDestinationsDelta(
+		desired.ko.Spec.KinesisStreamDestinations,
+		latest.ko.Spec.KinesisStreamDestinations,
+	)
+
+	for _, destination := range removed {
+		_, err = rm.sdkapi.DisableKinesisStreamingDestination(
+			ctx,
+			&svcsdk.DisableKinesisStreamingDestinationInput{
+				TableName: desired.ko.Spec.TableName,
+				StreamArn: destination.StreamARN,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "DisableKinesisStreamingDestination", err)
+		recordSDKCall("DisableKinesisStreamingDestination", err)
+		if err != nil {
+			return err
+		}
+	}
+	if len(removed) > 0 {
+		// Wait for the old destinations to finish disabling before enabling
+		// any new ones.
+		return requeueWaitKinesisDestinationActive
+	}
+
+	for _, destination := range updated {
+		input := &svcsdk.UpdateKinesisStreamingDestinationInput{
+			TableName: desired.ko.Spec.TableName,
+			StreamArn: destination.StreamARN,
+			UpdateKinesisStreamingConfiguration: &svcsdktypes.UpdateKinesisStreamingConfiguration{
+				ApproximateCreationDateTimePrecision: svcsdktypes.ApproximateCreationDateTimePrecision(
+					*destination.ApproximateCreationDateTimePrecision,
+				),
+			},
+		}
+		_, err = rm.sdkapi.UpdateKinesisStreamingDestination(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "UpdateKinesisStreamingDestination", err)
+		recordSDKCall("UpdateKinesisStreamingDestination", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, destination := range added {
+		input := &svcsdk.EnableKinesisStreamingDestinationInput{
+			TableName: desired.ko.Spec.TableName,
+			StreamArn: destination.StreamARN,
+		}
+		if destination.ApproximateCreationDateTimePrecision != nil {
+			input.EnableKinesisStreamingConfiguration = &svcsdktypes.EnableKinesisStreamingConfiguration{
+				ApproximateCreationDateTimePrecision: svcsdktypes.ApproximateCreationDateTimePrecision(
+					*destination.ApproximateCreationDateTimePrecision,
+				),
+			}
+		}
+		_, err = rm.sdkapi.EnableKinesisStreamingDestination(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "EnableKinesisStreamingDestination", err)
+		recordSDKCall("EnableKinesisStreamingDestination", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(added) > 0 || len(updated) > 0 {
+		return requeueWaitKinesisDestinationActive
+	}
+	return nil
+}
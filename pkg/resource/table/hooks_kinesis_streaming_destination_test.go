@@ -0,0 +1,189 @@
+package table
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func kinesisDestination(streamARN string, precision *string) *v1alpha1.KinesisDataStreamDestination {
+	return &v1alpha1.KinesisDataStreamDestination{
+		StreamARN:                            aws.String(streamARN),
+		ApproximateCreationDateTimePrecision: precision,
+	}
+}
+
+func Test_equalKinesisStreamDestinations(t *testing.T) {
+	type args struct {
+		a []*v1alpha1.KinesisDataStreamDestination
+		b []*v1alpha1.KinesisDataStreamDestination
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "empty desired list removes all destinations",
+			args: args{
+				a: []*v1alpha1.KinesisDataStreamDestination{},
+				b: []*v1alpha1.KinesisDataStreamDestination{
+					kinesisDestination("arn:stream/one", nil),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "same stream, unmanaged precision",
+			args: args{
+				a: []*v1alpha1.KinesisDataStreamDestination{
+					kinesisDestination("arn:stream/one", nil),
+				},
+				b: []*v1alpha1.KinesisDataStreamDestination{
+					kinesisDestination("arn:stream/one", aws.String("MILLISECOND")),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "same stream, different precision",
+			args: args{
+				a: []*v1alpha1.KinesisDataStreamDestination{
+					kinesisDestination("arn:stream/one", aws.String("MICROSECOND")),
+				},
+				b: []*v1alpha1.KinesisDataStreamDestination{
+					kinesisDestination("arn:stream/one", aws.String("MILLISECOND")),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "different streams",
+			args: args{
+				a: []*v1alpha1.KinesisDataStreamDestination{
+					kinesisDestination("arn:stream/two", nil),
+				},
+				b: []*v1alpha1.KinesisDataStreamDestination{
+					kinesisDestination("arn:stream/one", nil),
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := equalKinesisStreamDestinations(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("equalKinesisStreamDestinations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_computeKinesisStreamDestinationsDelta(t *testing.T) {
+	desired := []*v1alpha1.KinesisDataStreamDestination{
+		kinesisDestination("arn:stream/one", aws.String("MICROSECOND")),
+		kinesisDestination("arn:stream/three", nil),
+	}
+	latest := []*v1alpha1.KinesisDataStreamDestination{
+		kinesisDestination("arn:stream/one", aws.String("MILLISECOND")),
+		kinesisDestination("arn:stream/two", nil),
+	}
+	added, updated, removed := computeKinesisStreamDestinationsDelta(desired, latest)
+	wantAdded := []*v1alpha1.KinesisDataStreamDestination{desired[1]}
+	wantUpdated := []*v1alpha1.KinesisDataStreamDestination{desired[0]}
+	wantRemoved := []*v1alpha1.KinesisDataStreamDestination{latest[1]}
+	if !reflect.DeepEqual(added, wantAdded) {
+		t.Errorf("computeKinesisStreamDestinationsDelta() added = %v, want %v", added, wantAdded)
+	}
+	if !reflect.DeepEqual(updated, wantUpdated) {
+		t.Errorf("computeKinesisStreamDestinationsDelta() updated = %v, want %v", updated, wantUpdated)
+	}
+	if !reflect.DeepEqual(removed, wantRemoved) {
+		t.Errorf("computeKinesisStreamDestinationsDelta() removed = %v, want %v", removed, wantRemoved)
+	}
+}
+
+func Test_validateKinesisStreamDestinations(t *testing.T) {
+	t.Run("both accepted precisions pass", func(t *testing.T) {
+		r := &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				KinesisStreamDestinations: []*v1alpha1.KinesisDataStreamDestination{
+					kinesisDestination("arn:stream/one", aws.String("MILLISECOND")),
+					kinesisDestination("arn:stream/two", aws.String("MICROSECOND")),
+					kinesisDestination("arn:stream/three", nil),
+				},
+			},
+		}}
+		if err := validateKinesisStreamDestinations(r); err != nil {
+			t.Errorf("validateKinesisStreamDestinations() error = %v, want nil", err)
+		}
+	})
+
+	t.Run("an unknown precision goes terminal", func(t *testing.T) {
+		r := &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				KinesisStreamDestinations: []*v1alpha1.KinesisDataStreamDestination{
+					kinesisDestination("arn:stream/one", aws.String("NANOSECOND")),
+				},
+			},
+		}}
+		err := validateKinesisStreamDestinations(r)
+		var termErr *ackerr.TerminalError
+		if !errors.As(err, &termErr) {
+			t.Errorf("validateKinesisStreamDestinations() error = %v, want a terminal error", err)
+		}
+	})
+}
+
+func Test_syncKinesisStreamingDestinations_precisionChange(t *testing.T) {
+	rm := &resourceManager{}
+	newDesired := func() *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName: aws.String("table"),
+				KinesisStreamDestinations: []*v1alpha1.KinesisDataStreamDestination{
+					kinesisDestination("arn:stream/one", aws.String("MICROSECOND")),
+				},
+			},
+		}}
+	}
+	newLatest := func(status string) *resource {
+		destination := kinesisDestination("arn:stream/one", aws.String("MILLISECOND"))
+		destination.DestinationStatus = aws.String(status)
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:                 aws.String("table"),
+				KinesisStreamDestinations: []*v1alpha1.KinesisDataStreamDestination{destination},
+			},
+		}}
+	}
+
+	t.Run("an ENABLING destination requeues the precision change", func(t *testing.T) {
+		// rm.sdkapi is nil: reaching any Kinesis call would panic, so the
+		// requeue proves the change was queued without an API call.
+		err := rm.syncKinesisStreamingDestinations(context.Background(), newDesired(), newLatest("ENABLING"))
+		if err != requeueWaitKinesisDestinationActive {
+			t.Errorf("syncKinesisStreamingDestinations() error = %v, want %v", err, requeueWaitKinesisDestinationActive)
+		}
+	})
+
+	t.Run("an ACTIVE destination proceeds straight to the update call", func(t *testing.T) {
+		// A precision-only change must not disable and re-enable the
+		// destination: the delta yields no removals, so the first (and only)
+		// SDK call reached is UpdateKinesisStreamingDestination, which
+		// panics on the nil client.
+		defer func() {
+			if recover() == nil {
+				t.Errorf("expected the sync to reach the UpdateKinesisStreamingDestination call")
+			}
+		}()
+		_ = rm.syncKinesisStreamingDestinations(context.Background(), newDesired(), newLatest("ACTIVE"))
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	flag "github.com/spf13/pflag"
+)
+
+// maxConcurrentTableMutations caps how many tables may have mutating
+// DynamoDB calls in flight at the same time. On a fleet of several hundred
+// tables, unbounded concurrent UpdateTable calls can trip the account-level
+// limit on concurrent control-plane operations and throttle unrelated
+// tooling. Zero keeps the historical unlimited behavior.
+var maxConcurrentTableMutations int
+
+func init() {
+	flag.IntVar(
+		&maxConcurrentTableMutations,
+		"max-concurrent-table-mutations",
+		0,
+		"Maximum number of tables that may have mutating DynamoDB calls in "+
+			"flight at the same time, protecting the account-level limit on "+
+			"concurrent control-plane operations. Describe/List reads are "+
+			"unaffected; zero means unlimited.",
+	)
+}
+
+var requeueWaitMutationSlot = ackrequeue.NeededAfter(
+	errors.New("concurrent table mutation cap reached, retrying"),
+	3*time.Second,
+)
+
+var (
+	// mutationSlots is the semaphore limiting concurrent mutating
+	// operations; nil when the cap is disabled.
+	mutationSlots     chan struct{}
+	mutationSlotsOnce sync.Once
+)
+
+// mutationSlotWaitStarts remembers when each table first failed to acquire a
+// slot, keyed by namespace/name, so the wait time histogram can be observed
+// once the table finally gets one.
+var mutationSlotWaitStarts sync.Map
+
+// acquireMutationSlot reserves a slot for mutating DynamoDB calls on behalf
+// of the given table. When the cap is reached it returns ok=false without
+// blocking; holding the worker goroutine while waiting would just move the
+// contention into the workqueue, so callers requeue with
+// requeueWaitMutationSlot instead. The returned release function must be
+// called (it is safe to call it once via defer) when the mutations are done.
+func acquireMutationSlot(r *resource) (release func(), ok bool) {
+	mutationSlotsOnce.Do(func() {
+		if maxConcurrentTableMutations > 0 {
+			mutationSlots = make(chan struct{}, maxConcurrentTableMutations)
+		}
+	})
+	if mutationSlots == nil {
+		return func() {}, true
+	}
+	key := r.ko.Namespace + "/" + r.ko.Name
+	select {
+	case mutationSlots <- struct{}{}:
+	default:
+		mutationSlotWaitStarts.LoadOrStore(key, time.Now())
+		tableMutationSlotDenials.Inc()
+		return nil, false
+	}
+	waited := time.Duration(0)
+	if started, loaded := mutationSlotWaitStarts.LoadAndDelete(key); loaded {
+		waited = time.Since(started.(time.Time))
+	}
+	tableMutationSlotWaitSeconds.Observe(waited.Seconds())
+	tableMutationsInFlight.Inc()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			tableMutationsInFlight.Dec()
+			<-mutationSlots
+		})
+	}, true
+}
@@ -0,0 +1,120 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"context"
+
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+// noOnDemandThroughputCap is the sentinel DynamoDB uses to remove a maximum
+// read or write request units cap from an on-demand table or index.
+const noOnDemandThroughputCap int64 = -1
+
+// syncTableOnDemandThroughput updates a given table maximum on-demand read
+// and write request units.
+func (rm *resourceManager) syncTableOnDemandThroughput(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTableOnDemandThroughput")
+	defer exit(err)
+
+	input := &svcsdk.UpdateTableInput{
+		TableName:          aws.String(*r.ko.Spec.TableName),
+		OnDemandThroughput: newSDKOnDemandThroughput(r.ko.Spec.OnDemandThroughput),
+	}
+	if input.OnDemandThroughput == nil {
+		// The whole spec field was removed: send the sentinel for both caps so
+		// DynamoDB drops any previously configured maximum.
+		input.OnDemandThroughput = &svcsdktypes.OnDemandThroughput{
+			MaxReadRequestUnits:  aws.Int64(noOnDemandThroughputCap),
+			MaxWriteRequestUnits: aws.Int64(noOnDemandThroughputCap),
+		}
+	}
+
+	_, err = rm.sdkapi.UpdateTable(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "UpdateTable", err)
+	recordSDKCall("UpdateTable", err)
+	if err != nil {
+		return err
+	}
+	return err
+}
+
+// newSDKOnDemandThroughput builds a new *svcsdktypes.OnDemandThroughput. A nil
+// maximum request units value means "no cap" and is sent as -1 so that a
+// previously set cap gets removed.
+func newSDKOnDemandThroughput(odt *v1alpha1.OnDemandThroughput) *svcsdktypes.OnDemandThroughput {
+	if odt == nil {
+		return nil
+	}
+	onDemandThroughput := &svcsdktypes.OnDemandThroughput{
+		MaxReadRequestUnits:  aws.Int64(noOnDemandThroughputCap),
+		MaxWriteRequestUnits: aws.Int64(noOnDemandThroughputCap),
+	}
+	if odt.MaxReadRequestUnits != nil {
+		onDemandThroughput.MaxReadRequestUnits = aws.Int64(*odt.MaxReadRequestUnits)
+	}
+	if odt.MaxWriteRequestUnits != nil {
+		onDemandThroughput.MaxWriteRequestUnits = aws.Int64(*odt.MaxWriteRequestUnits)
+	}
+	return onDemandThroughput
+}
+
+// equalOnDemandThroughputs returns whether two OnDemandThroughput objects are
+// equal or not. A nil maximum request units value and the -1 sentinel DynamoDB
+// reports for a removed cap are considered equal.
+func equalOnDemandThroughputs(
+	a *v1alpha1.OnDemandThroughput,
+	b *v1alpha1.OnDemandThroughput,
+) bool {
+	if !equalOnDemandThroughputCaps(maxReadRequestUnits(a), maxReadRequestUnits(b)) {
+		return false
+	}
+	return equalOnDemandThroughputCaps(maxWriteRequestUnits(a), maxWriteRequestUnits(b))
+}
+
+// equalOnDemandThroughputCaps returns whether two maximum request units values
+// are equal or not, treating nil and -1 both as "no cap".
+func equalOnDemandThroughputCaps(a *int64, b *int64) bool {
+	if a == nil || *a == noOnDemandThroughputCap {
+		return b == nil || *b == noOnDemandThroughputCap
+	}
+	if b == nil || *b == noOnDemandThroughputCap {
+		return false
+	}
+	return *a == *b
+}
+
+func maxReadRequestUnits(odt *v1alpha1.OnDemandThroughput) *int64 {
+	if odt == nil {
+		return nil
+	}
+	return odt.MaxReadRequestUnits
+}
+
+func maxWriteRequestUnits(odt *v1alpha1.OnDemandThroughput) *int64 {
+	if odt == nil {
+		return nil
+	}
+	return odt.MaxWriteRequestUnits
+}
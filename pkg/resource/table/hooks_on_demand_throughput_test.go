@@ -0,0 +1,166 @@
+package table
+
+import (
+	"reflect"
+	"testing"
+
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func Test_newSDKOnDemandThroughput(t *testing.T) {
+	type args struct {
+		odt *v1alpha1.OnDemandThroughput
+	}
+	tests := []struct {
+		name string
+		args args
+		want *svcsdktypes.OnDemandThroughput
+	}{
+		{
+			name: "on-demand throughput is nil",
+			args: args{
+				odt: nil,
+			},
+			want: nil,
+		},
+		{
+			name: "on-demand throughput is not nil, max read request units is nil",
+			args: args{
+				odt: &v1alpha1.OnDemandThroughput{
+					MaxReadRequestUnits:  nil,
+					MaxWriteRequestUnits: aws.Int64(10),
+				},
+			},
+			want: &svcsdktypes.OnDemandThroughput{
+				MaxReadRequestUnits:  aws.Int64(-1),
+				MaxWriteRequestUnits: aws.Int64(10),
+			},
+		},
+		{
+			name: "on-demand throughput is not nil, max write request units is nil",
+			args: args{
+				odt: &v1alpha1.OnDemandThroughput{
+					MaxReadRequestUnits:  aws.Int64(10),
+					MaxWriteRequestUnits: nil,
+				},
+			},
+			want: &svcsdktypes.OnDemandThroughput{
+				MaxReadRequestUnits:  aws.Int64(10),
+				MaxWriteRequestUnits: aws.Int64(-1),
+			},
+		},
+		{
+			name: "on-demand throughput is not nil, max read and write request units are not nil",
+			args: args{
+				odt: &v1alpha1.OnDemandThroughput{
+					MaxReadRequestUnits:  aws.Int64(5),
+					MaxWriteRequestUnits: aws.Int64(5),
+				},
+			},
+			want: &svcsdktypes.OnDemandThroughput{
+				MaxReadRequestUnits:  aws.Int64(5),
+				MaxWriteRequestUnits: aws.Int64(5),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newSDKOnDemandThroughput(tt.args.odt); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newSDKOnDemandThroughput() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_equalOnDemandThroughputs(t *testing.T) {
+	type args struct {
+		a *v1alpha1.OnDemandThroughput
+		b *v1alpha1.OnDemandThroughput
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "both nil",
+			args: args{
+				a: nil,
+				b: nil,
+			},
+			want: true,
+		},
+		{
+			name: "nil equals removed caps",
+			args: args{
+				a: nil,
+				b: &v1alpha1.OnDemandThroughput{
+					MaxReadRequestUnits:  aws.Int64(-1),
+					MaxWriteRequestUnits: aws.Int64(-1),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "nil differs from a set cap",
+			args: args{
+				a: nil,
+				b: &v1alpha1.OnDemandThroughput{
+					MaxReadRequestUnits: aws.Int64(100),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "same caps",
+			args: args{
+				a: &v1alpha1.OnDemandThroughput{
+					MaxReadRequestUnits:  aws.Int64(100),
+					MaxWriteRequestUnits: aws.Int64(50),
+				},
+				b: &v1alpha1.OnDemandThroughput{
+					MaxReadRequestUnits:  aws.Int64(100),
+					MaxWriteRequestUnits: aws.Int64(50),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "different write caps",
+			args: args{
+				a: &v1alpha1.OnDemandThroughput{
+					MaxReadRequestUnits:  aws.Int64(100),
+					MaxWriteRequestUnits: aws.Int64(50),
+				},
+				b: &v1alpha1.OnDemandThroughput{
+					MaxReadRequestUnits:  aws.Int64(100),
+					MaxWriteRequestUnits: aws.Int64(60),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "unset cap equals removed cap",
+			args: args{
+				a: &v1alpha1.OnDemandThroughput{
+					MaxReadRequestUnits: aws.Int64(100),
+				},
+				b: &v1alpha1.OnDemandThroughput{
+					MaxReadRequestUnits:  aws.Int64(100),
+					MaxWriteRequestUnits: aws.Int64(-1),
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := equalOnDemandThroughputs(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("equalOnDemandThroughputs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	flag "github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+// tableProgressDeadline is how long a table may stay continuously non-ACTIVE
+// before the controller flags it with the ProgressDeadlineExceeded condition
+// and a warning event. The controller keeps requeueing either way; the
+// condition only makes the stall visible on the CR.
+var tableProgressDeadline time.Duration
+
+func init() {
+	flag.DurationVar(
+		&tableProgressDeadline,
+		"table-progress-deadline",
+		2*time.Hour,
+		"How long a table may stay continuously non-ACTIVE before the "+
+			"ProgressDeadlineExceeded condition and a warning event are set on "+
+			"it. The timer restarts whenever the observed table status changes "+
+			"or a new mutation is issued; zero disables the check.",
+	)
+}
+
+// tableProgressEntry remembers the status a table was last observed in and
+// when that status was first seen.
+type tableProgressEntry struct {
+	status string
+	since  time.Time
+}
+
+// tableProgressTimes tracks how long each table has been observed in its
+// current non-ACTIVE status. Keyed by namespace/name.
+var tableProgressTimes sync.Map
+
+// progressNow returns the current time; tests substitute it to age entries
+// without sleeping.
+var progressNow = time.Now
+
+// checkTableProgressDeadline raises the ProgressDeadlineExceeded condition on
+// a table that has sat in the same non-ACTIVE status for longer than
+// tableProgressDeadline, and clears it again once the table settles. It is
+// called every time the table has been described, with the latest table
+// status already set on the resource.
+func checkTableProgressDeadline(r *resource) {
+	if r.ko.Status.TableStatus == nil {
+		return
+	}
+	key := r.ko.Namespace + "/" + r.ko.Name
+	status := *r.ko.Status.TableStatus
+
+	if status == string(v1alpha1.TableStatus_SDK_ACTIVE) ||
+		status == string(v1alpha1.TableStatus_SDK_ARCHIVED) {
+		tableProgressTimes.Delete(key)
+		if c := getConditionOfType(r, ConditionTypeProgressDeadlineExceeded); c != nil &&
+			c.Status == corev1.ConditionTrue {
+			msg := fmt.Sprintf("table reached %s", status)
+			setProgressDeadlineCondition(r, corev1.ConditionFalse, &msg)
+		}
+		return
+	}
+	if tableProgressDeadline <= 0 {
+		return
+	}
+
+	now := progressNow()
+	loaded, ok := tableProgressTimes.Load(key)
+	if !ok || loaded.(tableProgressEntry).status != status {
+		// First observation of this status: (re)start the timer. A status
+		// change means DynamoDB is making progress, even if the table has
+		// not reached ACTIVE yet.
+		tableProgressTimes.Store(key, tableProgressEntry{status: status, since: now})
+		return
+	}
+	elapsed := now.Sub(loaded.(tableProgressEntry).since)
+	if elapsed < tableProgressDeadline {
+		return
+	}
+	msg := fmt.Sprintf(
+		"table has been %s for %s, exceeding the progress deadline of %s",
+		status, elapsed.Round(time.Minute), tableProgressDeadline,
+	)
+	setProgressDeadlineCondition(r, corev1.ConditionTrue, &msg)
+	recordTableWarningEvent(r, "ProgressDeadlineExceeded", msg)
+}
+
+// resetTableProgressDeadline restarts the stall timer for the table. It is
+// called after the controller issues a new mutation, so that the deadline
+// measures a single operation rather than a string of successive ones.
+func resetTableProgressDeadline(r *resource) {
+	tableProgressTimes.Delete(r.ko.Namespace + "/" + r.ko.Name)
+}
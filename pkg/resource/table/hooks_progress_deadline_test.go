@@ -0,0 +1,116 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func Test_checkTableProgressDeadline(t *testing.T) {
+	origDeadline := tableProgressDeadline
+	origNow := progressNow
+	defer func() {
+		tableProgressDeadline = origDeadline
+		progressNow = origNow
+	}()
+	tableProgressDeadline = 2 * time.Hour
+
+	now := time.Now()
+	progressNow = func() time.Time { return now }
+	advance := func(d time.Duration) { now = now.Add(d) }
+
+	newTable := func(name, status string) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec:       v1alpha1.TableSpec{TableName: aws.String(name)},
+			Status:     v1alpha1.TableStatus{TableStatus: aws.String(status)},
+		}}
+	}
+	deadlineCondition := func(r *resource) *corev1.ConditionStatus {
+		c := getConditionOfType(r, ConditionTypeProgressDeadlineExceeded)
+		if c == nil {
+			return nil
+		}
+		return &c.Status
+	}
+
+	t.Run("a stalled update raises the condition and ACTIVE clears it", func(t *testing.T) {
+		r := newTable("stalled", "UPDATING")
+		checkTableProgressDeadline(r)
+		if deadlineCondition(r) != nil {
+			t.Fatalf("condition must not be raised on the first observation")
+		}
+		advance(time.Hour)
+		checkTableProgressDeadline(r)
+		if deadlineCondition(r) != nil {
+			t.Fatalf("condition must not be raised before the deadline")
+		}
+		advance(90 * time.Minute)
+		checkTableProgressDeadline(r)
+		if got := deadlineCondition(r); got == nil || *got != corev1.ConditionTrue {
+			t.Fatalf("condition = %v, want True after the deadline", got)
+		}
+
+		r.ko.Status.TableStatus = aws.String("ACTIVE")
+		checkTableProgressDeadline(r)
+		if got := deadlineCondition(r); got == nil || *got != corev1.ConditionFalse {
+			t.Fatalf("condition = %v, want False once the table is ACTIVE", got)
+		}
+	})
+
+	t.Run("an observed status change restarts the timer", func(t *testing.T) {
+		r := newTable("progressing", "CREATING")
+		checkTableProgressDeadline(r)
+		advance(90 * time.Minute)
+		r.ko.Status.TableStatus = aws.String("UPDATING")
+		checkTableProgressDeadline(r)
+		advance(90 * time.Minute)
+		checkTableProgressDeadline(r)
+		if deadlineCondition(r) != nil {
+			t.Fatalf("a status change must restart the timer")
+		}
+	})
+
+	t.Run("a new mutation restarts the timer", func(t *testing.T) {
+		r := newTable("mutated", "UPDATING")
+		checkTableProgressDeadline(r)
+		advance(90 * time.Minute)
+		resetTableProgressDeadline(r)
+		checkTableProgressDeadline(r)
+		advance(90 * time.Minute)
+		checkTableProgressDeadline(r)
+		if deadlineCondition(r) != nil {
+			t.Fatalf("a mutation must restart the timer")
+		}
+	})
+
+	t.Run("a zero deadline disables the check", func(t *testing.T) {
+		tableProgressDeadline = 0
+		defer func() { tableProgressDeadline = 2 * time.Hour }()
+		r := newTable("unchecked", "UPDATING")
+		checkTableProgressDeadline(r)
+		advance(240 * time.Hour)
+		checkTableProgressDeadline(r)
+		if deadlineCondition(r) != nil {
+			t.Fatalf("a zero deadline must disable the check")
+		}
+	})
+}
@@ -0,0 +1,442 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"context"
+	"fmt"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+// Provisioned replica capacity is managed through the dedicated
+// UpdateTableReplicaAutoScaling / DescribeTableReplicaAutoScaling APIs, not
+// through UpdateTable; without explicit settings every replica falls back to
+// the default scaling policy. The helpers in this file read the described
+// settings into the status, compare them against the spec and apply the spec
+// once the referenced replicas are ACTIVE.
+
+// getReplicaAutoScalingWithContext reads the auto scaling settings of every
+// replica of the table, including the per-GSI settings.
+func (rm *resourceManager) getReplicaAutoScalingWithContext(
+	ctx context.Context,
+	tableName *string,
+) ([]*v1alpha1.ReplicaAutoScalingDescription, error) {
+	var err error
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.getReplicaAutoScalingWithContext")
+	defer func(err error) { exit(err) }(err)
+
+	res, err := rm.sdkapi.DescribeTableReplicaAutoScaling(
+		ctx,
+		&svcsdk.DescribeTableReplicaAutoScalingInput{
+			TableName: tableName,
+		},
+	)
+	rm.metrics.RecordAPICall("GET", "DescribeTableReplicaAutoScaling", err)
+	recordSDKCall("DescribeTableReplicaAutoScaling", err)
+	if err != nil {
+		return nil, err
+	}
+	if res.TableAutoScalingDescription == nil {
+		return nil, nil
+	}
+	return replicaAutoScalingDescriptionsFromSDK(res.TableAutoScalingDescription.Replicas), nil
+}
+
+// replicaAutoScalingDescriptionsFromSDK converts the SDK replica auto scaling
+// descriptions into their status representation.
+func replicaAutoScalingDescriptionsFromSDK(
+	replicas []svcsdktypes.ReplicaAutoScalingDescription,
+) []*v1alpha1.ReplicaAutoScalingDescription {
+	if len(replicas) == 0 {
+		return nil
+	}
+	descriptions := []*v1alpha1.ReplicaAutoScalingDescription{}
+	for _, replica := range replicas {
+		description := &v1alpha1.ReplicaAutoScalingDescription{
+			RegionName: replica.RegionName,
+			ReplicaProvisionedReadCapacityAutoScalingSettings: autoScalingSettingsDescriptionFromSDK(
+				replica.ReplicaProvisionedReadCapacityAutoScalingSettings,
+			),
+			ReplicaProvisionedWriteCapacityAutoScalingSettings: autoScalingSettingsDescriptionFromSDK(
+				replica.ReplicaProvisionedWriteCapacityAutoScalingSettings,
+			),
+			ReplicaStatus: aws.String(string(replica.ReplicaStatus)),
+		}
+		for _, gsi := range replica.GlobalSecondaryIndexes {
+			description.GlobalSecondaryIndexes = append(
+				description.GlobalSecondaryIndexes,
+				&v1alpha1.ReplicaGlobalSecondaryIndexAutoScalingDescription{
+					IndexName:   gsi.IndexName,
+					IndexStatus: aws.String(string(gsi.IndexStatus)),
+					ProvisionedReadCapacityAutoScalingSettings: autoScalingSettingsDescriptionFromSDK(
+						gsi.ProvisionedReadCapacityAutoScalingSettings,
+					),
+					ProvisionedWriteCapacityAutoScalingSettings: autoScalingSettingsDescriptionFromSDK(
+						gsi.ProvisionedWriteCapacityAutoScalingSettings,
+					),
+				},
+			)
+		}
+		descriptions = append(descriptions, description)
+	}
+	return descriptions
+}
+
+func autoScalingSettingsDescriptionFromSDK(
+	settings *svcsdktypes.AutoScalingSettingsDescription,
+) *v1alpha1.AutoScalingSettingsDescription {
+	if settings == nil {
+		return nil
+	}
+	description := &v1alpha1.AutoScalingSettingsDescription{
+		AutoScalingDisabled: settings.AutoScalingDisabled,
+		AutoScalingRoleARN:  settings.AutoScalingRoleArn,
+		MaximumUnits:        settings.MaximumUnits,
+		MinimumUnits:        settings.MinimumUnits,
+	}
+	for _, policy := range settings.ScalingPolicies {
+		policyDescription := &v1alpha1.AutoScalingPolicyDescription{
+			PolicyName: policy.PolicyName,
+		}
+		if ttc := policy.TargetTrackingScalingPolicyConfiguration; ttc != nil {
+			ttcDescription := &v1alpha1.AutoScalingTargetTrackingScalingPolicyConfigurationDescription{
+				DisableScaleIn: ttc.DisableScaleIn,
+				TargetValue:    ttc.TargetValue,
+			}
+			if ttc.ScaleInCooldown != nil {
+				ttcDescription.ScaleInCooldown = aws.Int64(int64(*ttc.ScaleInCooldown))
+			}
+			if ttc.ScaleOutCooldown != nil {
+				ttcDescription.ScaleOutCooldown = aws.Int64(int64(*ttc.ScaleOutCooldown))
+			}
+			policyDescription.TargetTrackingScalingPolicyConfiguration = ttcDescription
+		}
+		description.ScalingPolicies = append(description.ScalingPolicies, policyDescription)
+	}
+	return description
+}
+
+// newUpdateTableReplicaAutoScalingPayload builds the UpdateTableReplicaAutoScaling
+// request carrying every replica auto scaling setting declared in the spec.
+func newUpdateTableReplicaAutoScalingPayload(r *resource) *svcsdk.UpdateTableReplicaAutoScalingInput {
+	input := &svcsdk.UpdateTableReplicaAutoScalingInput{
+		TableName: r.ko.Spec.TableName,
+	}
+	for _, replica := range r.ko.Spec.ReplicaAutoScaling {
+		replicaUpdate := svcsdktypes.ReplicaAutoScalingUpdate{
+			RegionName: replica.RegionName,
+			ReplicaProvisionedReadCapacityAutoScalingUpdate: autoScalingSettingsUpdateToSDK(
+				replica.ReplicaProvisionedReadCapacityAutoScalingUpdate,
+			),
+		}
+		for _, gsi := range replica.ReplicaGlobalSecondaryIndexUpdates {
+			replicaUpdate.ReplicaGlobalSecondaryIndexUpdates = append(
+				replicaUpdate.ReplicaGlobalSecondaryIndexUpdates,
+				svcsdktypes.ReplicaGlobalSecondaryIndexAutoScalingUpdate{
+					IndexName: gsi.IndexName,
+					ProvisionedReadCapacityAutoScalingUpdate: autoScalingSettingsUpdateToSDK(
+						gsi.ProvisionedReadCapacityAutoScalingUpdate,
+					),
+				},
+			)
+		}
+		input.ReplicaUpdates = append(input.ReplicaUpdates, replicaUpdate)
+	}
+	return input
+}
+
+func autoScalingSettingsUpdateToSDK(
+	update *v1alpha1.AutoScalingSettingsUpdate,
+) *svcsdktypes.AutoScalingSettingsUpdate {
+	if update == nil {
+		return nil
+	}
+	sdkUpdate := &svcsdktypes.AutoScalingSettingsUpdate{
+		AutoScalingDisabled: update.AutoScalingDisabled,
+		MaximumUnits:        update.MaximumUnits,
+		MinimumUnits:        update.MinimumUnits,
+	}
+	if update.ScalingPolicyUpdate != nil {
+		sdkUpdate.ScalingPolicyUpdate = &svcsdktypes.AutoScalingPolicyUpdate{
+			PolicyName: update.ScalingPolicyUpdate.PolicyName,
+		}
+		if ttc := update.ScalingPolicyUpdate.TargetTrackingScalingPolicyConfiguration; ttc != nil {
+			sdkTTC := &svcsdktypes.AutoScalingTargetTrackingScalingPolicyConfigurationUpdate{
+				DisableScaleIn: ttc.DisableScaleIn,
+				TargetValue:    ttc.TargetValue,
+			}
+			if ttc.ScaleInCooldown != nil {
+				sdkTTC.ScaleInCooldown = aws.Int32(int32(*ttc.ScaleInCooldown))
+			}
+			if ttc.ScaleOutCooldown != nil {
+				sdkTTC.ScaleOutCooldown = aws.Int32(int32(*ttc.ScaleOutCooldown))
+			}
+			sdkUpdate.ScalingPolicyUpdate.TargetTrackingScalingPolicyConfiguration = sdkTTC
+		}
+	}
+	return sdkUpdate
+}
+
+// validateReplicaAutoScaling returns a terminal error when the spec declares
+// a scaling policy without the target value UpdateTableReplicaAutoScaling
+// requires, so the bad spec surfaces immediately instead of burning API calls
+// on a ValidationException.
+func validateReplicaAutoScaling(r *resource) error {
+	for _, replica := range r.ko.Spec.ReplicaAutoScaling {
+		updates := []*v1alpha1.AutoScalingSettingsUpdate{
+			replica.ReplicaProvisionedReadCapacityAutoScalingUpdate,
+		}
+		for _, gsi := range replica.ReplicaGlobalSecondaryIndexUpdates {
+			updates = append(updates, gsi.ProvisionedReadCapacityAutoScalingUpdate)
+		}
+		for _, update := range updates {
+			if update == nil || update.ScalingPolicyUpdate == nil {
+				continue
+			}
+			ttc := update.ScalingPolicyUpdate.TargetTrackingScalingPolicyConfiguration
+			if ttc == nil || ttc.TargetValue == nil {
+				return ackerr.NewTerminalError(fmt.Errorf(
+					"replicaAutoScaling scaling policy updates must set " +
+						"targetTrackingScalingPolicyConfiguration.targetValue",
+				))
+			}
+		}
+	}
+	return nil
+}
+
+// replicaAutoScalingInSync returns true when every auto scaling setting the
+// spec declares matches the described settings. Fields the spec leaves unset
+// are unmanaged and do not count as drift.
+func replicaAutoScalingInSync(
+	specReplicas []*v1alpha1.ReplicaAutoScalingUpdate,
+	describedReplicas []*v1alpha1.ReplicaAutoScalingDescription,
+) bool {
+	for _, specReplica := range specReplicas {
+		if specReplica.RegionName == nil {
+			continue
+		}
+		described := describedReplicaAutoScaling(describedReplicas, *specReplica.RegionName)
+		if described == nil {
+			return false
+		}
+		if !autoScalingSettingsInSync(
+			specReplica.ReplicaProvisionedReadCapacityAutoScalingUpdate,
+			described.ReplicaProvisionedReadCapacityAutoScalingSettings,
+		) {
+			return false
+		}
+		for _, specGSI := range specReplica.ReplicaGlobalSecondaryIndexUpdates {
+			if specGSI.IndexName == nil {
+				continue
+			}
+			describedGSI := describedReplicaGSIAutoScaling(described.GlobalSecondaryIndexes, *specGSI.IndexName)
+			if describedGSI == nil {
+				return false
+			}
+			if !autoScalingSettingsInSync(
+				specGSI.ProvisionedReadCapacityAutoScalingUpdate,
+				describedGSI.ProvisionedReadCapacityAutoScalingSettings,
+			) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func describedReplicaAutoScaling(
+	descriptions []*v1alpha1.ReplicaAutoScalingDescription,
+	regionName string,
+) *v1alpha1.ReplicaAutoScalingDescription {
+	for _, description := range descriptions {
+		if description.RegionName != nil && *description.RegionName == regionName {
+			return description
+		}
+	}
+	return nil
+}
+
+func describedReplicaGSIAutoScaling(
+	descriptions []*v1alpha1.ReplicaGlobalSecondaryIndexAutoScalingDescription,
+	indexName string,
+) *v1alpha1.ReplicaGlobalSecondaryIndexAutoScalingDescription {
+	for _, description := range descriptions {
+		if description.IndexName != nil && *description.IndexName == indexName {
+			return description
+		}
+	}
+	return nil
+}
+
+// autoScalingSettingsInSync compares only the fields the update sets against
+// the described settings; a nil update manages nothing and is always in sync.
+func autoScalingSettingsInSync(
+	update *v1alpha1.AutoScalingSettingsUpdate,
+	described *v1alpha1.AutoScalingSettingsDescription,
+) bool {
+	if update == nil {
+		return true
+	}
+	if described == nil {
+		return false
+	}
+	if update.AutoScalingDisabled != nil &&
+		!equalBoolPtrValues(update.AutoScalingDisabled, described.AutoScalingDisabled) {
+		return false
+	}
+	if update.MinimumUnits != nil &&
+		(described.MinimumUnits == nil || *update.MinimumUnits != *described.MinimumUnits) {
+		return false
+	}
+	if update.MaximumUnits != nil &&
+		(described.MaximumUnits == nil || *update.MaximumUnits != *described.MaximumUnits) {
+		return false
+	}
+	if update.ScalingPolicyUpdate == nil ||
+		update.ScalingPolicyUpdate.TargetTrackingScalingPolicyConfiguration == nil {
+		return true
+	}
+	specTTC := update.ScalingPolicyUpdate.TargetTrackingScalingPolicyConfiguration
+	describedTTC := describedTargetTracking(described.ScalingPolicies, update.ScalingPolicyUpdate.PolicyName)
+	if describedTTC == nil {
+		return false
+	}
+	if specTTC.TargetValue != nil &&
+		(describedTTC.TargetValue == nil || *specTTC.TargetValue != *describedTTC.TargetValue) {
+		return false
+	}
+	if specTTC.DisableScaleIn != nil &&
+		!equalBoolPtrValues(specTTC.DisableScaleIn, describedTTC.DisableScaleIn) {
+		return false
+	}
+	if specTTC.ScaleInCooldown != nil &&
+		(describedTTC.ScaleInCooldown == nil || *specTTC.ScaleInCooldown != *describedTTC.ScaleInCooldown) {
+		return false
+	}
+	if specTTC.ScaleOutCooldown != nil &&
+		(describedTTC.ScaleOutCooldown == nil || *specTTC.ScaleOutCooldown != *describedTTC.ScaleOutCooldown) {
+		return false
+	}
+	return true
+}
+
+// describedTargetTracking returns the target tracking configuration of the
+// described policy matching policyName, or of the sole described policy when
+// the spec does not name one.
+func describedTargetTracking(
+	policies []*v1alpha1.AutoScalingPolicyDescription,
+	policyName *string,
+) *v1alpha1.AutoScalingTargetTrackingScalingPolicyConfigurationDescription {
+	for _, policy := range policies {
+		if policyName != nil {
+			if policy.PolicyName != nil && *policy.PolicyName == *policyName {
+				return policy.TargetTrackingScalingPolicyConfiguration
+			}
+			continue
+		}
+		return policy.TargetTrackingScalingPolicyConfiguration
+	}
+	return nil
+}
+
+// equalBoolPtrValues treats nil as false, matching how DynamoDB reports
+// boolean settings that were never explicitly enabled.
+func equalBoolPtrValues(a, b *bool) bool {
+	av := a != nil && *a
+	bv := b != nil && *b
+	return av == bv
+}
+
+// replicaActiveInRegion returns true when the table has an ACTIVE replica in
+// the given region.
+func replicaActiveInRegion(latest *resource, regionName string) bool {
+	for _, replica := range latest.ko.Status.Replicas {
+		if replica.RegionName == nil || *replica.RegionName != regionName {
+			continue
+		}
+		return replica.ReplicaStatus != nil &&
+			*replica.ReplicaStatus == string(svcsdktypes.ReplicaStatusActive)
+	}
+	return false
+}
+
+// replicaDeclaredInSpec returns true when the spec declares a replica in the
+// given region.
+func replicaDeclaredInSpec(desired *resource, regionName string) bool {
+	for _, replica := range desired.ko.Spec.TableReplicas {
+		if replica.RegionName != nil && *replica.RegionName == regionName {
+			return true
+		}
+	}
+	return false
+}
+
+// syncReplicaAutoScaling applies the replica auto scaling settings declared
+// in the spec through UpdateTableReplicaAutoScaling. The call is rejected
+// while a referenced replica is not ACTIVE, so the change is queued with a
+// requeue until the replica settles instead of surfacing the
+// ValidationException.
+func (rm *resourceManager) syncReplicaAutoScaling(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncReplicaAutoScaling")
+	defer func(err error) { exit(err) }(err)
+
+	if err := validateReplicaAutoScaling(desired); err != nil {
+		return err
+	}
+	for _, replica := range desired.ko.Spec.ReplicaAutoScaling {
+		if replica.RegionName == nil {
+			continue
+		}
+		if replicaActiveInRegion(latest, *replica.RegionName) {
+			continue
+		}
+		// A replica that exists but is still transitioning, or that the spec
+		// declares and the replica sync has not created yet, becomes ACTIVE
+		// on its own; wait it out. A region that is neither a replica nor
+		// declared as one can never be scaled and goes terminal.
+		if checkIfReplicasInProgress(latest.ko.Status.Replicas, *replica.RegionName) ||
+			replicaDeclaredInSpec(desired, *replica.RegionName) {
+			return requeueWaitReplicasActive
+		}
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"replicaAutoScaling references region %q which is not a replica of the table",
+			*replica.RegionName,
+		))
+	}
+
+	_, err = rm.sdkapi.UpdateTableReplicaAutoScaling(ctx, newUpdateTableReplicaAutoScalingPayload(desired))
+	rm.metrics.RecordAPICall("UPDATE", "UpdateTableReplicaAutoScaling", err)
+	recordSDKCall("UpdateTableReplicaAutoScaling", err)
+	if err != nil {
+		return err
+	}
+	recordTableEvent(desired, "UpdatedReplicaAutoScaling", fmt.Sprintf(
+		"applied auto scaling settings to %d replica(s)", len(desired.ko.Spec.ReplicaAutoScaling),
+	))
+	return nil
+}
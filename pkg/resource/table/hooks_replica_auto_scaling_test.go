@@ -0,0 +1,247 @@
+package table
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func testReplicaAutoScaling(region string, minUnits, maxUnits int64, targetValue float64) *v1alpha1.ReplicaAutoScalingUpdate {
+	return &v1alpha1.ReplicaAutoScalingUpdate{
+		RegionName: aws.String(region),
+		ReplicaProvisionedReadCapacityAutoScalingUpdate: &v1alpha1.AutoScalingSettingsUpdate{
+			MinimumUnits: aws.Int64(minUnits),
+			MaximumUnits: aws.Int64(maxUnits),
+			ScalingPolicyUpdate: &v1alpha1.AutoScalingPolicyUpdate{
+				TargetTrackingScalingPolicyConfiguration: &v1alpha1.AutoScalingTargetTrackingScalingPolicyConfigurationUpdate{
+					TargetValue: aws.Float64(targetValue),
+				},
+			},
+		},
+	}
+}
+
+func testReplicaAutoScalingDescription(region string, minUnits, maxUnits int64, targetValue float64) *v1alpha1.ReplicaAutoScalingDescription {
+	return &v1alpha1.ReplicaAutoScalingDescription{
+		RegionName: aws.String(region),
+		ReplicaProvisionedReadCapacityAutoScalingSettings: &v1alpha1.AutoScalingSettingsDescription{
+			MinimumUnits: aws.Int64(minUnits),
+			MaximumUnits: aws.Int64(maxUnits),
+			ScalingPolicies: []*v1alpha1.AutoScalingPolicyDescription{
+				{
+					TargetTrackingScalingPolicyConfiguration: &v1alpha1.AutoScalingTargetTrackingScalingPolicyConfigurationDescription{
+						TargetValue: aws.Float64(targetValue),
+					},
+				},
+			},
+		},
+		ReplicaStatus: aws.String("ACTIVE"),
+	}
+}
+
+func Test_newUpdateTableReplicaAutoScalingPayload(t *testing.T) {
+	scaling := testReplicaAutoScaling("us-west-2", 5, 100, 70)
+	scaling.ReplicaGlobalSecondaryIndexUpdates = []*v1alpha1.ReplicaGlobalSecondaryIndexAutoScalingUpdate{
+		{
+			IndexName: aws.String("index1"),
+			ProvisionedReadCapacityAutoScalingUpdate: &v1alpha1.AutoScalingSettingsUpdate{
+				MinimumUnits: aws.Int64(1),
+				MaximumUnits: aws.Int64(10),
+			},
+		},
+	}
+	r := &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{
+			TableName:          aws.String("table"),
+			ReplicaAutoScaling: []*v1alpha1.ReplicaAutoScalingUpdate{scaling},
+		},
+	}}
+
+	input := newUpdateTableReplicaAutoScalingPayload(r)
+	if *input.TableName != "table" {
+		t.Errorf("TableName = %q, want %q", *input.TableName, "table")
+	}
+	if len(input.ReplicaUpdates) != 1 {
+		t.Fatalf("got %d replica updates, want 1", len(input.ReplicaUpdates))
+	}
+	replicaUpdate := input.ReplicaUpdates[0]
+	if *replicaUpdate.RegionName != "us-west-2" {
+		t.Errorf("RegionName = %q, want %q", *replicaUpdate.RegionName, "us-west-2")
+	}
+	readUpdate := replicaUpdate.ReplicaProvisionedReadCapacityAutoScalingUpdate
+	if readUpdate == nil || *readUpdate.MinimumUnits != 5 || *readUpdate.MaximumUnits != 100 {
+		t.Fatalf("unexpected read capacity update %+v", readUpdate)
+	}
+	ttc := readUpdate.ScalingPolicyUpdate.TargetTrackingScalingPolicyConfiguration
+	if ttc == nil || *ttc.TargetValue != 70 {
+		t.Fatalf("unexpected target tracking configuration %+v", ttc)
+	}
+	if len(replicaUpdate.ReplicaGlobalSecondaryIndexUpdates) != 1 {
+		t.Fatalf("got %d GSI updates, want 1", len(replicaUpdate.ReplicaGlobalSecondaryIndexUpdates))
+	}
+	gsiUpdate := replicaUpdate.ReplicaGlobalSecondaryIndexUpdates[0]
+	if *gsiUpdate.IndexName != "index1" ||
+		*gsiUpdate.ProvisionedReadCapacityAutoScalingUpdate.MaximumUnits != 10 {
+		t.Errorf("unexpected GSI update %+v", gsiUpdate)
+	}
+}
+
+func Test_replicaAutoScalingInSync(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      []*v1alpha1.ReplicaAutoScalingUpdate
+		described []*v1alpha1.ReplicaAutoScalingDescription
+		want      bool
+	}{
+		{
+			name:      "matching settings are in sync",
+			spec:      []*v1alpha1.ReplicaAutoScalingUpdate{testReplicaAutoScaling("us-west-2", 5, 100, 70)},
+			described: []*v1alpha1.ReplicaAutoScalingDescription{testReplicaAutoScalingDescription("us-west-2", 5, 100, 70)},
+			want:      true,
+		},
+		{
+			name:      "different maximum units drift",
+			spec:      []*v1alpha1.ReplicaAutoScalingUpdate{testReplicaAutoScaling("us-west-2", 5, 100, 70)},
+			described: []*v1alpha1.ReplicaAutoScalingDescription{testReplicaAutoScalingDescription("us-west-2", 5, 200, 70)},
+			want:      false,
+		},
+		{
+			name:      "different target value drifts",
+			spec:      []*v1alpha1.ReplicaAutoScalingUpdate{testReplicaAutoScaling("us-west-2", 5, 100, 70)},
+			described: []*v1alpha1.ReplicaAutoScalingDescription{testReplicaAutoScalingDescription("us-west-2", 5, 100, 50)},
+			want:      false,
+		},
+		{
+			name:      "undescribed region drifts",
+			spec:      []*v1alpha1.ReplicaAutoScalingUpdate{testReplicaAutoScaling("eu-west-1", 5, 100, 70)},
+			described: []*v1alpha1.ReplicaAutoScalingDescription{testReplicaAutoScalingDescription("us-west-2", 5, 100, 70)},
+			want:      false,
+		},
+		{
+			name: "unset spec fields are unmanaged",
+			spec: []*v1alpha1.ReplicaAutoScalingUpdate{
+				{
+					RegionName: aws.String("us-west-2"),
+					ReplicaProvisionedReadCapacityAutoScalingUpdate: &v1alpha1.AutoScalingSettingsUpdate{
+						MaximumUnits: aws.Int64(100),
+					},
+				},
+			},
+			described: []*v1alpha1.ReplicaAutoScalingDescription{testReplicaAutoScalingDescription("us-west-2", 5, 100, 70)},
+			want:      true,
+		},
+		{
+			name: "GSI settings drift",
+			spec: func() []*v1alpha1.ReplicaAutoScalingUpdate {
+				scaling := testReplicaAutoScaling("us-west-2", 5, 100, 70)
+				scaling.ReplicaGlobalSecondaryIndexUpdates = []*v1alpha1.ReplicaGlobalSecondaryIndexAutoScalingUpdate{
+					{
+						IndexName: aws.String("index1"),
+						ProvisionedReadCapacityAutoScalingUpdate: &v1alpha1.AutoScalingSettingsUpdate{
+							MaximumUnits: aws.Int64(10),
+						},
+					},
+				}
+				return []*v1alpha1.ReplicaAutoScalingUpdate{scaling}
+			}(),
+			described: func() []*v1alpha1.ReplicaAutoScalingDescription {
+				description := testReplicaAutoScalingDescription("us-west-2", 5, 100, 70)
+				description.GlobalSecondaryIndexes = []*v1alpha1.ReplicaGlobalSecondaryIndexAutoScalingDescription{
+					{
+						IndexName: aws.String("index1"),
+						ProvisionedReadCapacityAutoScalingSettings: &v1alpha1.AutoScalingSettingsDescription{
+							MaximumUnits: aws.Int64(20),
+						},
+					},
+				}
+				return []*v1alpha1.ReplicaAutoScalingDescription{description}
+			}(),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := replicaAutoScalingInSync(tt.spec, tt.described); got != tt.want {
+				t.Errorf("replicaAutoScalingInSync() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_syncReplicaAutoScaling(t *testing.T) {
+	newDesired := func() *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:          aws.String("table"),
+				TableReplicas:      []*v1alpha1.CreateReplicationGroupMemberAction{testReplica("us-west-2")},
+				ReplicaAutoScaling: []*v1alpha1.ReplicaAutoScalingUpdate{testReplicaAutoScaling("us-west-2", 5, 100, 70)},
+			},
+		}}
+	}
+	newLatest := func(replicaStatus string) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Status: v1alpha1.TableStatus{
+				Replicas: []*v1alpha1.ReplicaDescription{
+					{
+						RegionName:    aws.String("us-west-2"),
+						ReplicaStatus: aws.String(replicaStatus),
+					},
+				},
+			},
+		}}
+	}
+	rm := &resourceManager{}
+
+	t.Run("a CREATING replica queues the change", func(t *testing.T) {
+		// rm.sdkapi is nil: reaching the UpdateTableReplicaAutoScaling call
+		// would panic, so returning the requeue proves no API call was made.
+		err := rm.syncReplicaAutoScaling(context.Background(), newDesired(), newLatest("CREATING"))
+		if err != requeueWaitReplicasActive {
+			t.Errorf("syncReplicaAutoScaling() error = %v, want %v", err, requeueWaitReplicasActive)
+		}
+	})
+
+	t.Run("a declared but not yet created replica queues the change", func(t *testing.T) {
+		latest := &resource{ko: &v1alpha1.Table{}}
+		err := rm.syncReplicaAutoScaling(context.Background(), newDesired(), latest)
+		if err != requeueWaitReplicasActive {
+			t.Errorf("syncReplicaAutoScaling() error = %v, want %v", err, requeueWaitReplicasActive)
+		}
+	})
+
+	t.Run("an unknown region goes terminal", func(t *testing.T) {
+		desired := newDesired()
+		desired.ko.Spec.TableReplicas = nil
+		err := rm.syncReplicaAutoScaling(context.Background(), desired, &resource{ko: &v1alpha1.Table{}})
+		var termErr *ackerr.TerminalError
+		if !errors.As(err, &termErr) {
+			t.Errorf("syncReplicaAutoScaling() error = %v, want a terminal error", err)
+		}
+	})
+
+	t.Run("a policy without a target value goes terminal", func(t *testing.T) {
+		desired := newDesired()
+		desired.ko.Spec.ReplicaAutoScaling[0].
+			ReplicaProvisionedReadCapacityAutoScalingUpdate.
+			ScalingPolicyUpdate.TargetTrackingScalingPolicyConfiguration.TargetValue = nil
+		err := rm.syncReplicaAutoScaling(context.Background(), desired, newLatest("ACTIVE"))
+		var termErr *ackerr.TerminalError
+		if !errors.As(err, &termErr) {
+			t.Errorf("syncReplicaAutoScaling() error = %v, want a terminal error", err)
+		}
+	})
+
+	t.Run("an ACTIVE replica proceeds to the API call", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("expected the sync to reach the UpdateTableReplicaAutoScaling call")
+			}
+		}()
+		_ = rm.syncReplicaAutoScaling(context.Background(), newDesired(), newLatest("ACTIVE"))
+	})
+}
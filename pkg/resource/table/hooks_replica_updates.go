@@ -33,7 +33,7 @@ func equalCreateReplicationGroupMemberActions(a, b *v1alpha1.CreateReplicationGr
 	if !equalStrings(a.KMSMasterKeyID, b.KMSMasterKeyID) {
 		return false
 	}
-	if !equalStrings(a.TableClassOverride, b.TableClassOverride) {
+	if !equalTableClassOverrides(a.TableClassOverride, b.TableClassOverride) {
 		return false
 	}
 	if a.ProvisionedThroughputOverride != nil && b.ProvisionedThroughputOverride != nil {
@@ -43,10 +43,38 @@ func equalCreateReplicationGroupMemberActions(a, b *v1alpha1.CreateReplicationGr
 	} else if (a.ProvisionedThroughputOverride == nil) != (b.ProvisionedThroughputOverride == nil) {
 		return false
 	}
+	if !equalOnDemandThroughputOverrides(a.OnDemandThroughputOverride, b.OnDemandThroughputOverride) {
+		return false
+	}
 
 	return equalReplicaGlobalSecondaryIndexArrays(a.GlobalSecondaryIndexes, b.GlobalSecondaryIndexes)
 }
 
+// equalTableClassOverrides compares two table class override values, treating
+// an unset override as the service default of STANDARD. DescribeTable always
+// reports a ReplicaTableClassSummary, so without the normalization a replica
+// created without an override would show a perpetual diff against the
+// observed STANDARD class.
+func equalTableClassOverrides(a, b *string) bool {
+	av := string(svcsdktypes.TableClassStandard)
+	if a != nil {
+		av = *a
+	}
+	bv := string(svcsdktypes.TableClassStandard)
+	if b != nil {
+		bv = *b
+	}
+	return av == bv
+}
+
+// equalOnDemandThroughputOverrides compares two OnDemandThroughputOverride objects
+func equalOnDemandThroughputOverrides(a, b *v1alpha1.OnDemandThroughputOverride) bool {
+	if a != nil && b != nil {
+		return equalInt64s(a.MaxReadRequestUnits, b.MaxReadRequestUnits)
+	}
+	return (a == nil) == (b == nil)
+}
+
 // equalReplicaGlobalSecondaryIndexes compares two ReplicaGlobalSecondaryIndex objects
 func equalReplicaGlobalSecondaryIndexes(
 	a *v1alpha1.ReplicaGlobalSecondaryIndex,
@@ -64,7 +92,7 @@ func equalReplicaGlobalSecondaryIndexes(
 		return false
 	}
 
-	return true
+	return equalOnDemandThroughputOverrides(a.OnDemandThroughputOverride, b.OnDemandThroughputOverride)
 }
 
 // equalReplicaGlobalSecondaryIndexArrays compares two arrays of ReplicaGlobalSecondaryIndex objects
@@ -170,6 +198,12 @@ func createReplicaUpdate(replica *v1alpha1.CreateReplicationGroupMemberAction) s
 		}
 	}
 
+	if replica.OnDemandThroughputOverride != nil {
+		createAction.OnDemandThroughputOverride = &svcsdktypes.OnDemandThroughputOverride{
+			MaxReadRequestUnits: replica.OnDemandThroughputOverride.MaxReadRequestUnits,
+		}
+	}
+
 	if replica.GlobalSecondaryIndexes != nil {
 		gsiList := []svcsdktypes.ReplicaGlobalSecondaryIndex{}
 		for _, gsi := range replica.GlobalSecondaryIndexes {
@@ -183,6 +217,11 @@ func createReplicaUpdate(replica *v1alpha1.CreateReplicationGroupMemberAction) s
 					replicaGSI.ProvisionedThroughputOverride.ReadCapacityUnits = gsi.ProvisionedThroughputOverride.ReadCapacityUnits
 				}
 			}
+			if gsi.OnDemandThroughputOverride != nil {
+				replicaGSI.OnDemandThroughputOverride = &svcsdktypes.OnDemandThroughputOverride{
+					MaxReadRequestUnits: gsi.OnDemandThroughputOverride.MaxReadRequestUnits,
+				}
+			}
 			gsiList = append(gsiList, replicaGSI)
 		}
 		createAction.GlobalSecondaryIndexes = gsiList
@@ -217,17 +256,36 @@ func updateReplicaUpdate(replica *v1alpha1.CreateReplicationGroupMemberAction) s
 		}
 	}
 
-	// Only include GSIs that have provisioned throughput overrides
+	if replica.OnDemandThroughputOverride != nil &&
+		replica.OnDemandThroughputOverride.MaxReadRequestUnits != nil {
+		updateAction.OnDemandThroughputOverride = &svcsdktypes.OnDemandThroughputOverride{
+			MaxReadRequestUnits: replica.OnDemandThroughputOverride.MaxReadRequestUnits,
+		}
+	}
+
+	// Only include GSIs that have throughput overrides
 	var gsisWithOverrides []svcsdktypes.ReplicaGlobalSecondaryIndex
 	for _, gsi := range replica.GlobalSecondaryIndexes {
-		if gsi.IndexName != nil && gsi.ProvisionedThroughputOverride != nil &&
+		if gsi.IndexName == nil {
+			continue
+		}
+		gsiOverride := svcsdktypes.ReplicaGlobalSecondaryIndex{
+			IndexName: aws.String(*gsi.IndexName),
+		}
+		if gsi.ProvisionedThroughputOverride != nil &&
 			gsi.ProvisionedThroughputOverride.ReadCapacityUnits != nil {
-			gsisWithOverrides = append(gsisWithOverrides, svcsdktypes.ReplicaGlobalSecondaryIndex{
-				IndexName: aws.String(*gsi.IndexName),
-				ProvisionedThroughputOverride: &svcsdktypes.ProvisionedThroughputOverride{
-					ReadCapacityUnits: gsi.ProvisionedThroughputOverride.ReadCapacityUnits,
-				},
-			})
+			gsiOverride.ProvisionedThroughputOverride = &svcsdktypes.ProvisionedThroughputOverride{
+				ReadCapacityUnits: gsi.ProvisionedThroughputOverride.ReadCapacityUnits,
+			}
+		}
+		if gsi.OnDemandThroughputOverride != nil &&
+			gsi.OnDemandThroughputOverride.MaxReadRequestUnits != nil {
+			gsiOverride.OnDemandThroughputOverride = &svcsdktypes.OnDemandThroughputOverride{
+				MaxReadRequestUnits: gsi.OnDemandThroughputOverride.MaxReadRequestUnits,
+			}
+		}
+		if gsiOverride.ProvisionedThroughputOverride != nil || gsiOverride.OnDemandThroughputOverride != nil {
+			gsisWithOverrides = append(gsisWithOverrides, gsiOverride)
 		}
 	}
 
@@ -240,6 +298,7 @@ func updateReplicaUpdate(replica *v1alpha1.CreateReplicationGroupMemberAction) s
 	hasUpdates := updateAction.KMSMasterKeyId != nil ||
 		updateAction.TableClassOverride != "" ||
 		updateAction.ProvisionedThroughputOverride != nil ||
+		updateAction.OnDemandThroughputOverride != nil ||
 		len(updateAction.GlobalSecondaryIndexes) > 0
 
 	if hasUpdates {
@@ -290,10 +349,16 @@ func (rm *resourceManager) syncReplicas(
 	if err != nil {
 		return err
 	}
+	if len(input.ReplicaUpdates) == 0 {
+		// Every queued action is already reflected by the table; there is
+		// nothing left to send this reconcile.
+		return nil
+	}
 
 	// Call the UpdateTable API
 	_, err = rm.sdkapi.UpdateTable(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateTable", err)
+	recordSDKCall("UpdateTable", err)
 	if err != nil {
 		return err
 	}
@@ -324,6 +389,24 @@ func (rm *resourceManager) newUpdateTableReplicaUpdatesOneAtATimePayload(
 
 	createReplicas, updateReplicas, deleteRegions := computeReplicaupdatesDelta(latest, desired)
 
+	// A replica that is being created, deleted or has its table class
+	// switched keeps its ReplicaStatus off ACTIVE for a while, and DynamoDB
+	// rejects further replica mutations during that window. Defer every
+	// pending action until all replicas are ACTIVE again, the same way GSI
+	// changes are serialized one at a time.
+	if len(createReplicas)+len(updateReplicas)+len(deleteRegions) > 0 &&
+		tableReplicasTransitioning(latest) {
+		return nil, 0, requeueWaitReplicasActive
+	}
+
+	// Drop create actions for regions the table already reports: a create
+	// issued just before a controller restart leaves the replica on the
+	// server while the freshly recomputed delta still queues it, and
+	// DynamoDB rejects the duplicate action. The next step is derived from
+	// the observed replica set, never from what a previous reconcile
+	// believed it had sent.
+	createReplicas = filterOutReportedReplicas(createReplicas, latest.ko.Status.Replicas)
+
 	input = &svcsdk.UpdateTableInput{
 		TableName:      aws.String(*desired.ko.Spec.TableName),
 		ReplicaUpdates: []svcsdktypes.ReplicationGroupUpdate{},
@@ -341,6 +424,11 @@ func (rm *resourceManager) newUpdateTableReplicaUpdatesOneAtATimePayload(
 			return nil, 0, requeueWaitReplicasActive
 		}
 		rlog.Debug("creating replica in region", "table", *desired.ko.Spec.TableName, "region", *replica.RegionName)
+		// The consistency mode is only accepted while the replicas that make
+		// the table global are being created.
+		if desired.ko.Spec.MultiRegionConsistency != nil {
+			input.MultiRegionConsistency = svcsdktypes.MultiRegionConsistency(*desired.ko.Spec.MultiRegionConsistency)
+		}
 		input.ReplicaUpdates = append(input.ReplicaUpdates, createReplicaUpdate(createReplicas[0]))
 		return input, replicasInQueue, nil
 	}
@@ -436,6 +524,11 @@ func setTableReplicas(ko *svcapitypes.Table, replicas []svcsdktypes.ReplicaDescr
 					ReadCapacityUnits: replica.ProvisionedThroughputOverride.ReadCapacityUnits,
 				}
 			}
+			if replica.OnDemandThroughputOverride != nil {
+				replicaElem.OnDemandThroughputOverride = &v1alpha1.OnDemandThroughputOverride{
+					MaxReadRequestUnits: replica.OnDemandThroughputOverride.MaxReadRequestUnits,
+				}
+			}
 			if replica.GlobalSecondaryIndexes != nil {
 				gsiList := []*v1alpha1.ReplicaGlobalSecondaryIndex{}
 				for _, gsi := range replica.GlobalSecondaryIndexes {
@@ -447,6 +540,11 @@ func setTableReplicas(ko *svcapitypes.Table, replicas []svcsdktypes.ReplicaDescr
 							ReadCapacityUnits: gsi.ProvisionedThroughputOverride.ReadCapacityUnits,
 						}
 					}
+					if gsi.OnDemandThroughputOverride != nil {
+						gsiElem.OnDemandThroughputOverride = &v1alpha1.OnDemandThroughputOverride{
+							MaxReadRequestUnits: gsi.OnDemandThroughputOverride.MaxReadRequestUnits,
+						}
+					}
 					gsiList = append(gsiList, gsiElem)
 				}
 				replicaElem.GlobalSecondaryIndexes = gsiList
@@ -462,6 +560,46 @@ func setTableReplicas(ko *svcapitypes.Table, replicas []svcsdktypes.ReplicaDescr
 	}
 }
 
+// tableReplicasTransitioning returns true while any replica of the table is
+// in a non-ACTIVE state. A table with replicas is not ready for consumers
+// until every replica reports ACTIVE, even when the table itself already
+// does.
+func tableReplicasTransitioning(r *resource) bool {
+	for _, replica := range r.ko.Status.Replicas {
+		if replica == nil || replica.ReplicaStatus == nil {
+			continue
+		}
+		if *replica.ReplicaStatus != string(svcsdktypes.ReplicaStatusActive) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterOutReportedReplicas returns the members of createReplicas whose
+// region is not yet present in the table's replica descriptions; a replica
+// the table already reports does not need another create action, whatever
+// its status.
+func filterOutReportedReplicas(
+	createReplicas []*v1alpha1.CreateReplicationGroupMemberAction,
+	replicaDescriptions []*svcapitypes.ReplicaDescription,
+) []*v1alpha1.CreateReplicationGroupMemberAction {
+	var pending []*v1alpha1.CreateReplicationGroupMemberAction
+	for _, replica := range createReplicas {
+		reported := false
+		for _, description := range replicaDescriptions {
+			if description.RegionName != nil && *description.RegionName == *replica.RegionName {
+				reported = true
+				break
+			}
+		}
+		if !reported {
+			pending = append(pending, replica)
+		}
+	}
+	return pending
+}
+
 func checkIfReplicasInProgress(ReplicaDescription []*svcapitypes.ReplicaDescription, regionName string) bool {
 	for _, replica := range ReplicaDescription {
 		if *replica.RegionName == regionName {
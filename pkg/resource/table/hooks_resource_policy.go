@@ -0,0 +1,139 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+)
+
+// getResourcePolicyWithContext returns the table's resource-based policy
+// document and its current revision id. A table without a policy returns a
+// nil document.
+func (rm *resourceManager) getResourcePolicyWithContext(
+	ctx context.Context,
+	resourceARN *string,
+) (policy *string, revisionID *string, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.getResourcePolicyWithContext")
+	defer func(err error) { exit(err) }(err)
+
+	resp, err := rm.sdkapi.GetResourcePolicy(
+		ctx,
+		&svcsdk.GetResourcePolicyInput{
+			ResourceArn: resourceARN,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_ONE", "GetResourcePolicy", err)
+	recordSDKCall("GetResourcePolicy", err)
+	if err != nil {
+		if awsErr, ok := ackerr.AWSError(err); ok && awsErr.ErrorCode() == "PolicyNotFoundException" {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	return resp.Policy, resp.RevisionId, nil
+}
+
+// equalResourcePolicyDocuments compares two JSON policy documents
+// semantically, so that whitespace or key-ordering differences in the
+// document returned by GetResourcePolicy do not register as drift. Documents
+// that fail to parse are compared as plain strings.
+func equalResourcePolicyDocuments(a *string, b *string) bool {
+	if aws.ToString(a) == "" || aws.ToString(b) == "" {
+		return aws.ToString(a) == aws.ToString(b)
+	}
+	var aDoc, bDoc interface{}
+	if err := json.Unmarshal([]byte(*a), &aDoc); err != nil {
+		return *a == *b
+	}
+	if err := json.Unmarshal([]byte(*b), &bDoc); err != nil {
+		return *a == *b
+	}
+	return reflect.DeepEqual(aDoc, bDoc)
+}
+
+// maxResourcePolicyWriteAttempts bounds how many times one reconcile
+// re-reads the policy revision and retries a write after an
+// optimistic-concurrency conflict.
+const maxResourcePolicyWriteAttempts = 3
+
+// isPolicyRevisionConflict returns true when a policy write was rejected
+// because the revision id passed as ExpectedRevisionId no longer matches,
+// i.e. another actor changed the policy between our read and our write.
+func isPolicyRevisionConflict(err error) bool {
+	awsErr, ok := ackerr.AWSError(err)
+	return ok && awsErr.ErrorCode() == "PolicyNotFoundException"
+}
+
+// syncResourcePolicy reconciles the table's resource-based policy. The
+// current revision id is passed back as ExpectedRevisionId so a policy that
+// changed out-of-band between the read and the write fails the call instead
+// of being silently overwritten; on such a conflict the revision is re-read
+// and the write retried a bounded number of times within the same reconcile.
+func (rm *resourceManager) syncResourcePolicy(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncResourcePolicy")
+	defer func(err error) { exit(err) }(err)
+
+	resourceARN := aws.String(string(*latest.ko.Status.ACKResourceMetadata.ARN))
+	for attempt := 1; ; attempt++ {
+		var revisionID *string
+		_, revisionID, err = rm.getResourcePolicyWithContext(ctx, resourceARN)
+		if err != nil {
+			return err
+		}
+
+		if aws.ToString(desired.ko.Spec.ResourcePolicy) == "" {
+			if revisionID == nil {
+				return nil
+			}
+			_, err = rm.sdkapi.DeleteResourcePolicy(
+				ctx,
+				&svcsdk.DeleteResourcePolicyInput{
+					ResourceArn:        resourceARN,
+					ExpectedRevisionId: revisionID,
+				},
+			)
+			rm.metrics.RecordAPICall("UPDATE", "DeleteResourcePolicy", err)
+			recordSDKCall("DeleteResourcePolicy", err)
+		} else {
+			input := &svcsdk.PutResourcePolicyInput{
+				ResourceArn: resourceARN,
+				Policy:      desired.ko.Spec.ResourcePolicy,
+			}
+			if revisionID != nil {
+				input.ExpectedRevisionId = revisionID
+			}
+			_, err = rm.sdkapi.PutResourcePolicy(ctx, input)
+			rm.metrics.RecordAPICall("UPDATE", "PutResourcePolicy", err)
+			recordSDKCall("PutResourcePolicy", err)
+		}
+		if err == nil || !isPolicyRevisionConflict(err) || attempt >= maxResourcePolicyWriteAttempts {
+			return err
+		}
+		rlog.Debug("resource policy revision changed out of band, retrying with a fresh revision",
+			"attempt", attempt)
+	}
+}
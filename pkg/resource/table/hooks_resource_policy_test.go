@@ -0,0 +1,105 @@
+package table
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	smithy "github.com/aws/smithy-go"
+)
+
+func Test_equalResourcePolicyDocuments(t *testing.T) {
+	type args struct {
+		a *string
+		b *string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "both unset",
+			args: args{
+				a: nil,
+				b: nil,
+			},
+			want: true,
+		},
+		{
+			name: "policy removed from spec",
+			args: args{
+				a: nil,
+				b: aws.String(`{"Version":"2012-10-17"}`),
+			},
+			want: false,
+		},
+		{
+			name: "same document, different formatting",
+			args: args{
+				a: aws.String(`{"Version": "2012-10-17", "Statement": []}`),
+				b: aws.String(`{"Statement":[],"Version":"2012-10-17"}`),
+			},
+			want: true,
+		},
+		{
+			name: "different documents",
+			args: args{
+				a: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow"}]}`),
+				b: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny"}]}`),
+			},
+			want: false,
+		},
+		{
+			name: "unparseable documents fall back to string comparison",
+			args: args{
+				a: aws.String("not-json"),
+				b: aws.String("not-json"),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := equalResourcePolicyDocuments(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("equalResourcePolicyDocuments() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isPolicyRevisionConflict(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "nil error",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "revision mismatch",
+			err:  &smithy.GenericAPIError{Code: "PolicyNotFoundException"},
+			want: true,
+		},
+		{
+			name: "validation error",
+			err:  &smithy.GenericAPIError{Code: "ValidationException"},
+			want: false,
+		},
+		{
+			name: "non-AWS error",
+			err:  errors.New("PolicyNotFoundException"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isPolicyRevisionConflict(tt.err); got != tt.want {
+				t.Errorf("isPolicyRevisionConflict() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package table
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	smithy "github.com/aws/smithy-go"
+
+	"github.com/aws/aws-sdk-go/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+func Test_restoreSourceBackupChanged(t *testing.T) {
+	backupARN := "arn:aws:dynamodb:us-west-2:123456789012:table/table/backup/b1"
+	tests := []struct {
+		name           string
+		desiredARN     *string
+		restoreSummary *v1alpha1.RestoreSummary
+		want           bool
+	}{
+		{
+			name:           "field not set",
+			desiredARN:     nil,
+			restoreSummary: nil,
+			want:           false,
+		},
+		{
+			name:           "field unset on a restored table",
+			desiredARN:     nil,
+			restoreSummary: &v1alpha1.RestoreSummary{SourceBackupARN: aws.String(backupARN)},
+			want:           false,
+		},
+		{
+			name:           "matches the restore summary",
+			desiredARN:     aws.String(backupARN),
+			restoreSummary: &v1alpha1.RestoreSummary{SourceBackupARN: aws.String(backupARN)},
+			want:           false,
+		},
+		{
+			name:           "set on a table that was not restored",
+			desiredARN:     aws.String(backupARN),
+			restoreSummary: nil,
+			want:           true,
+		},
+		{
+			name:       "different backup ARN",
+			desiredARN: aws.String(backupARN),
+			restoreSummary: &v1alpha1.RestoreSummary{
+				SourceBackupARN: aws.String("arn:aws:dynamodb:us-west-2:123456789012:table/table/backup/b2"),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := &resource{ko: &v1alpha1.Table{
+				Spec: v1alpha1.TableSpec{
+					TableName:              aws.String("table"),
+					RestoreSourceBackupARN: tt.desiredARN,
+				},
+			}}
+			latest := &resource{ko: &v1alpha1.Table{
+				Status: v1alpha1.TableStatus{
+					RestoreSummary: tt.restoreSummary,
+				},
+			}}
+			if got := restoreSourceBackupChanged(desired, latest); got != tt.want {
+				t.Errorf("restoreSourceBackupChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_newRestoreTableFromBackupInput(t *testing.T) {
+	backupARN := "arn:aws:dynamodb:us-west-2:123456789012:table/table/backup/b1"
+
+	t.Run("spec settings map onto restore overrides", func(t *testing.T) {
+		r := &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:              aws.String("table"),
+				RestoreSourceBackupARN: aws.String(backupARN),
+				BillingMode:            aws.String("PROVISIONED"),
+				ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(5),
+					WriteCapacityUnits: aws.Int64(5),
+				},
+				SSESpecification: &v1alpha1.SSESpecification{
+					Enabled:        aws.Bool(true),
+					SSEType:        aws.String("KMS"),
+					KMSMasterKeyID: aws.String("alias/dynamo-prod"),
+				},
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					{
+						IndexName: aws.String("index1"),
+						KeySchema: []*v1alpha1.KeySchemaElement{
+							{AttributeName: aws.String("attr"), KeyType: aws.String("HASH")},
+						},
+						Projection: &v1alpha1.Projection{ProjectionType: aws.String("ALL")},
+						ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+							ReadCapacityUnits:  aws.Int64(10),
+							WriteCapacityUnits: aws.Int64(10),
+						},
+					},
+				},
+			},
+		}}
+		input, err := newRestoreTableFromBackupInput(r)
+		if err != nil {
+			t.Fatalf("newRestoreTableFromBackupInput() error = %v", err)
+		}
+		if *input.BackupArn != backupARN || *input.TargetTableName != "table" {
+			t.Errorf("newRestoreTableFromBackupInput() target = %v/%v", input.TargetTableName, input.BackupArn)
+		}
+		if input.BillingModeOverride != svcsdktypes.BillingModeProvisioned {
+			t.Errorf("newRestoreTableFromBackupInput() billing mode = %v", input.BillingModeOverride)
+		}
+		if input.ProvisionedThroughputOverride == nil || *input.ProvisionedThroughputOverride.ReadCapacityUnits != 5 {
+			t.Errorf("newRestoreTableFromBackupInput() throughput = %v", input.ProvisionedThroughputOverride)
+		}
+		if input.SSESpecificationOverride == nil || input.SSESpecificationOverride.SSEType != svcsdktypes.SSETypeKms {
+			t.Errorf("newRestoreTableFromBackupInput() SSE = %v", input.SSESpecificationOverride)
+		}
+		if len(input.GlobalSecondaryIndexOverride) != 1 || *input.GlobalSecondaryIndexOverride[0].IndexName != "index1" {
+			t.Errorf("newRestoreTableFromBackupInput() GSIs = %v", input.GlobalSecondaryIndexOverride)
+		}
+	})
+
+	t.Run("GSI missing write capacity units", func(t *testing.T) {
+		r := &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:              aws.String("table"),
+				RestoreSourceBackupARN: aws.String(backupARN),
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					{
+						IndexName: aws.String("index1"),
+						ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+							ReadCapacityUnits: aws.Int64(10),
+						},
+					},
+				},
+			},
+		}}
+		if _, err := newRestoreTableFromBackupInput(r); err == nil {
+			t.Errorf("newRestoreTableFromBackupInput() expected an error for a GSI missing write capacity units")
+		}
+	})
+}
+
+func Test_newRestoreTableToPointInTimeInput(t *testing.T) {
+	sourceARN := "arn:aws:dynamodb:us-west-2:123456789012:table/source"
+	restoreTime := metav1.NewTime(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	t.Run("restore to a specific point in time", func(t *testing.T) {
+		r := &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName: aws.String("table"),
+				RestoreFrom: &v1alpha1.RestoreTableToPointInTimeSpecification{
+					SourceTableARN:  aws.String(sourceARN),
+					RestoreDateTime: &restoreTime,
+				},
+				BillingMode: aws.String("PAY_PER_REQUEST"),
+			},
+		}}
+		input, err := newRestoreTableToPointInTimeInput(r)
+		if err != nil {
+			t.Fatalf("newRestoreTableToPointInTimeInput() error = %v", err)
+		}
+		if *input.SourceTableArn != sourceARN || *input.TargetTableName != "table" {
+			t.Errorf("newRestoreTableToPointInTimeInput() source = %v/%v", input.SourceTableArn, input.TargetTableName)
+		}
+		if input.RestoreDateTime == nil || !input.RestoreDateTime.Equal(restoreTime.Time) {
+			t.Errorf("newRestoreTableToPointInTimeInput() restore time = %v", input.RestoreDateTime)
+		}
+		if input.BillingModeOverride != svcsdktypes.BillingModePayPerRequest {
+			t.Errorf("newRestoreTableToPointInTimeInput() billing mode = %v", input.BillingModeOverride)
+		}
+	})
+
+	t.Run("restore to the latest restorable time", func(t *testing.T) {
+		r := &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName: aws.String("table"),
+				RestoreFrom: &v1alpha1.RestoreTableToPointInTimeSpecification{
+					SourceTableName:         aws.String("source"),
+					UseLatestRestorableTime: aws.Bool(true),
+				},
+			},
+		}}
+		input, err := newRestoreTableToPointInTimeInput(r)
+		if err != nil {
+			t.Fatalf("newRestoreTableToPointInTimeInput() error = %v", err)
+		}
+		if *input.SourceTableName != "source" || input.RestoreDateTime != nil {
+			t.Errorf("newRestoreTableToPointInTimeInput() source = %v, restore time = %v", input.SourceTableName, input.RestoreDateTime)
+		}
+		if input.UseLatestRestorableTime == nil || !*input.UseLatestRestorableTime {
+			t.Errorf("newRestoreTableToPointInTimeInput() useLatestRestorableTime = %v", input.UseLatestRestorableTime)
+		}
+	})
+}
+
+func Test_mapRestoreError(t *testing.T) {
+	rm := &resourceManager{
+		awsAccountID: "222222222222",
+		awsRoleARN:   "arn:aws:iam::222222222222:role/staging-restore",
+	}
+
+	t.Run("access denied goes terminal and names the assumed role", func(t *testing.T) {
+		err := rm.mapRestoreError(&smithy.GenericAPIError{
+			Code:    "AccessDeniedException",
+			Message: "not authorized to perform dynamodb:RestoreTableFromBackup",
+		})
+		var termErr *ackerr.TerminalError
+		if !errors.As(err, &termErr) {
+			t.Fatalf("mapRestoreError() error = %v, want a terminal error", err)
+		}
+		if !strings.Contains(err.Error(), "arn:aws:iam::222222222222:role/staging-restore") {
+			t.Errorf("terminal error %q does not name the assumed role", err.Error())
+		}
+		if !strings.Contains(err.Error(), "222222222222") {
+			t.Errorf("terminal error %q does not name the target account", err.Error())
+		}
+	})
+
+	t.Run("without a role mapping the controller credentials are named", func(t *testing.T) {
+		rm := &resourceManager{awsAccountID: "111111111111"}
+		err := rm.mapRestoreError(&smithy.GenericAPIError{Code: "AccessDenied"})
+		if !strings.Contains(err.Error(), "controller's own credentials") {
+			t.Errorf("terminal error %q does not mention the controller credentials", err.Error())
+		}
+	})
+
+	t.Run("other errors pass through for the normal handling", func(t *testing.T) {
+		origErr := &smithy.GenericAPIError{Code: "InternalServerError"}
+		if err := rm.mapRestoreError(origErr); err != origErr {
+			t.Errorf("mapRestoreError() error = %v, want %v", err, origErr)
+		}
+	})
+}
+
+func Test_filterRestorableGSIOverrides(t *testing.T) {
+	gsiOverride := func(names ...string) []svcsdktypes.GlobalSecondaryIndex {
+		out := []svcsdktypes.GlobalSecondaryIndex{}
+		for _, name := range names {
+			out = append(out, svcsdktypes.GlobalSecondaryIndex{IndexName: aws.String(name)})
+		}
+		return out
+	}
+
+	t.Run("indexes absent from the backup are deferred", func(t *testing.T) {
+		input := &svcsdk.RestoreTableFromBackupInput{
+			GlobalSecondaryIndexOverride: gsiOverride("index1", "index2", "index3"),
+		}
+		deferred := filterRestorableGSIOverrides(input, map[string]bool{
+			"index1": true, "index3": true,
+		})
+		if len(input.GlobalSecondaryIndexOverride) != 2 ||
+			*input.GlobalSecondaryIndexOverride[0].IndexName != "index1" ||
+			*input.GlobalSecondaryIndexOverride[1].IndexName != "index3" {
+			t.Errorf("filterRestorableGSIOverrides() kept = %v", input.GlobalSecondaryIndexOverride)
+		}
+		if len(deferred) != 1 || deferred[0] != "index2" {
+			t.Errorf("filterRestorableGSIOverrides() deferred = %v, want [index2]", deferred)
+		}
+	})
+
+	t.Run("all indexes in the backup restore as-is", func(t *testing.T) {
+		input := &svcsdk.RestoreTableFromBackupInput{
+			GlobalSecondaryIndexOverride: gsiOverride("index1"),
+		}
+		deferred := filterRestorableGSIOverrides(input, map[string]bool{"index1": true})
+		if len(input.GlobalSecondaryIndexOverride) != 1 || deferred != nil {
+			t.Errorf("filterRestorableGSIOverrides() = %v, %v", input.GlobalSecondaryIndexOverride, deferred)
+		}
+	})
+
+	t.Run("an empty backup defers every index", func(t *testing.T) {
+		input := &svcsdk.RestoreTableFromBackupInput{
+			GlobalSecondaryIndexOverride: gsiOverride("index1", "index2"),
+		}
+		deferred := filterRestorableGSIOverrides(input, map[string]bool{})
+		if len(input.GlobalSecondaryIndexOverride) != 0 {
+			t.Errorf("filterRestorableGSIOverrides() kept = %v, want none", input.GlobalSecondaryIndexOverride)
+		}
+		if len(deferred) != 2 {
+			t.Errorf("filterRestorableGSIOverrides() deferred = %v, want both indexes", deferred)
+		}
+	})
+}
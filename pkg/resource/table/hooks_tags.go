@@ -15,15 +15,66 @@ package table
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
 	ackutil "github.com/aws-controllers-k8s/runtime/pkg/util"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 
 	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/dynamodb-controller/pkg/sdkclient"
 )
 
+const (
+	// reservedTagKeyPrefix is the prefix of tag keys that are owned by AWS
+	// services (e.g. Control Tower or CloudFormation). These tags cannot be
+	// added or removed through the tagging APIs, so the controller leaves
+	// them alone.
+	reservedTagKeyPrefix = "aws:"
+	// maxTagsPerAPICall is the maximum number of tags (or tag keys) sent in
+	// a single TagResource/UntagResource request.
+	maxTagsPerAPICall = 50
+)
+
+var requeueWaitWhileTagging = ackrequeue.NeededAfter(
+	errors.New("rate limited while syncing tags, retrying"),
+	10*time.Second,
+)
+
+// AnnotationTagManagementPolicy is an annotation whose value selects how the
+// controller reconciles the resource's tags against Spec.Tags:
+// "authoritative" (the default) adds, updates and removes tags until the
+// server matches the spec exactly; "merge" only adds and updates keys the
+// spec declares and never removes others; "none" skips tag reconciliation
+// entirely after create. The merge and none policies are meant for tags
+// owned by an external governance tool.
+const AnnotationTagManagementPolicy = "dynamodb.services.k8s.aws/tag-management-policy"
+
+const (
+	tagPolicyAuthoritative = "authoritative"
+	tagPolicyMerge         = "merge"
+	tagPolicyNone          = "none"
+)
+
+// tagManagementPolicy returns the tag management policy selected by the
+// resource's annotations, defaulting to authoritative for a missing or
+// unrecognized value.
+func tagManagementPolicy(r *resource) string {
+	switch r.ko.ObjectMeta.GetAnnotations()[AnnotationTagManagementPolicy] {
+	case tagPolicyMerge:
+		return tagPolicyMerge
+	case tagPolicyNone:
+		return tagPolicyNone
+	}
+	return tagPolicyAuthoritative
+}
+
 // syncTableTags updates a dynamodb table tags.
 //
 // TODO(hilalymh): move this function to a common utility file. This function can be reused
@@ -38,40 +89,182 @@ func (rm *resourceManager) syncTableTags(
 	defer exit(err)
 
 	added, removed := computeTagsDelta(desired.ko.Spec.Tags, latest.ko.Spec.Tags)
+	switch tagManagementPolicy(desired) {
+	case tagPolicyNone:
+		return nil
+	case tagPolicyMerge:
+		removed = nil
+	}
 
+	err = rm.applyTagsDelta(
+		ctx,
+		rm.sdkapi,
+		string(*latest.ko.Status.ACKResourceMetadata.ARN),
+		added,
+		removed,
+	)
+	if err != nil {
+		return err
+	}
+	if len(added) > 0 || len(removed) > 0 {
+		recordTableEvent(desired, "UpdatedTags", fmt.Sprintf(
+			"tags: %d added or updated, %d removed", len(added), len(removed),
+		))
+	}
+	return nil
+}
+
+// applyTagsDelta sends the added and removed tags to the given resource ARN
+// in batches of maxTagsPerAPICall. The client is a parameter so that the
+// same delta can be applied to replica ARNs through region-bound clients.
+func (rm *resourceManager) applyTagsDelta(
+	ctx context.Context,
+	sdkapi *svcsdk.Client,
+	resourceARN string,
+	added []*v1alpha1.Tag,
+	removed []string,
+) (err error) {
 	// There are no API calls to update an existing tag. To update a tag we will have to first
 	// delete it and then recreate it with the new value.
 
-	if len(removed) > 0 {
-		_, err = rm.sdkapi.UntagResource(
+	for start := 0; start < len(removed); start += maxTagsPerAPICall {
+		end := min(start+maxTagsPerAPICall, len(removed))
+		_, err = sdkapi.UntagResource(
 			ctx,
 			&svcsdk.UntagResourceInput{
-				ResourceArn: (*string)(latest.ko.Status.ACKResourceMetadata.ARN),
-				TagKeys:     removed,
+				ResourceArn: &resourceARN,
+				TagKeys:     removed[start:end],
 			},
 		)
 		rm.metrics.RecordAPICall("GET", "UntagResource", err)
+		recordSDKCall("UntagResource", err)
 		if err != nil {
-			return err
+			return tagAPIError(err)
 		}
 	}
 
-	if len(added) > 0 {
-		_, err = rm.sdkapi.TagResource(
+	for start := 0; start < len(added); start += maxTagsPerAPICall {
+		end := min(start+maxTagsPerAPICall, len(added))
+		_, err = sdkapi.TagResource(
 			ctx,
 			&svcsdk.TagResourceInput{
-				ResourceArn: (*string)(latest.ko.Status.ACKResourceMetadata.ARN),
-				Tags:        sdkTagsFromResourceTags(added),
+				ResourceArn: &resourceARN,
+				Tags:        sdkTagsFromResourceTags(added[start:end]),
 			},
 		)
-		rm.metrics.RecordAPICall("GET", "UntagResource", err)
+		rm.metrics.RecordAPICall("GET", "TagResource", err)
+		recordSDKCall("TagResource", err)
+		if err != nil {
+			return tagAPIError(err)
+		}
+	}
+	return nil
+}
+
+// syncReplicaTags propagates the table's desired tags to each of its
+// replicas. Tags are regional: TagResource against the source table's ARN
+// does not reach the replica ARNs in other regions, so every replica is
+// tagged through a client bound to its region. A replica that does not
+// exist yet is skipped; the open replica delta requeues the update and the
+// tags land once the replica has been created.
+func (rm *resourceManager) syncReplicaTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncReplicaTags")
+	defer exit(err)
+
+	if latest.ko.Status.ACKResourceMetadata == nil || latest.ko.Status.ACKResourceMetadata.ARN == nil {
+		return nil
+	}
+	sourceARN := string(*latest.ko.Status.ACKResourceMetadata.ARN)
+
+	_, removed := computeTagsDelta(desired.ko.Spec.Tags, latest.ko.Spec.Tags)
+	switch tagManagementPolicy(desired) {
+	case tagPolicyNone:
+		return nil
+	case tagPolicyMerge:
+		removed = nil
+	}
+	// The full desired tag set is written rather than just the changed tags:
+	// a replica created after the source table was tagged starts out without
+	// any tags at all. TagResource overwrites existing values, so re-sending
+	// unchanged tags is harmless.
+	var applied []*v1alpha1.Tag
+	for _, tag := range desired.ko.Spec.Tags {
+		if strings.HasPrefix(*tag.Key, reservedTagKeyPrefix) {
+			continue
+		}
+		applied = append(applied, tag)
+	}
+
+	for _, replica := range desired.ko.Spec.TableReplicas {
+		if replica.RegionName == nil || *replica.RegionName == string(rm.awsRegion) {
+			continue
+		}
+		arn, err := replicaARN(sourceARN, *replica.RegionName)
+		if err != nil {
+			return err
+		}
+		err = rm.applyTagsDelta(ctx, rm.sdkClientForRegion(*replica.RegionName), arn, applied, removed)
 		if err != nil {
+			if awsErr, ok := ackerr.AWSError(err); ok && awsErr.ErrorCode() == "ResourceNotFoundException" {
+				rlog.Debug(
+					"replica does not exist yet, deferring tag sync",
+					"table", sourceARN, "region", *replica.RegionName,
+				)
+				continue
+			}
 			return err
 		}
 	}
 	return nil
 }
 
+// replicaARN returns the ARN of the table's replica in the given region. A
+// replica shares the source table's partition, account and name; only the
+// region component differs.
+func replicaARN(sourceARN, region string) (string, error) {
+	// arn:partition:service:region:account:resource
+	parts := strings.Split(sourceARN, ":")
+	if len(parts) < 6 {
+		return "", fmt.Errorf("cannot derive replica ARN from malformed table ARN %q", sourceARN)
+	}
+	parts[3] = region
+	return strings.Join(parts, ":"), nil
+}
+
+// sdkClientForRegion returns a DynamoDB client that targets the given region
+// with the same credentials and configuration (including the flag-configured
+// timeout and retryer) as the manager's own client.
+func (rm *resourceManager) sdkClientForRegion(region string) *svcsdk.Client {
+	return sdkclient.NewFromConfig(rm.clientcfg, func(o *svcsdk.Options) {
+		o.Region = region
+	})
+}
+
+// tagAPIError turns a tagging API rate limit into a requeue so that tag
+// churn does not fail the remainder of the table update.
+func tagAPIError(err error) error {
+	if awsErr, ok := ackerr.AWSError(err); ok && awsErr.ErrorCode() == "LimitExceededException" {
+		return requeueWaitWhileTagging
+	}
+	return err
+}
+
+// isTagReadSoftError returns true for the transient errors ListTagsOfResource
+// returns right after CreateTable, before the table's ARN has propagated to
+// the tagging APIs: ResourceNotFoundException or a throttle.
+func isTagReadSoftError(err error) bool {
+	if isThrottlingError(err) {
+		return true
+	}
+	awsErr, ok := ackerr.AWSError(err)
+	return ok && awsErr.ErrorCode() == "ResourceNotFoundException"
+}
+
 // equalTags returns true if two Tag arrays are equal regardless of the order
 // of their elements.
 func equalTags(
@@ -109,6 +302,10 @@ func sdkTagsFromResourceTags(rTags []*v1alpha1.Tag) []svcsdktypes.Tag {
 // computeTagsDelta compares two Tag arrays and return three different list
 // containing the added, updated and removed tags.
 // The removed tags only contains the Key of tags
+//
+// Keys with the reserved "aws:" prefix are skipped on both sides: they are
+// managed by AWS and any attempt to add or remove them is rejected by the
+// tagging APIs.
 func computeTagsDelta(
 	a []*v1alpha1.Tag,
 	b []*v1alpha1.Tag,
@@ -116,6 +313,9 @@ func computeTagsDelta(
 	var visitedIndexes []string
 mainLoop:
 	for _, aElement := range b {
+		if strings.HasPrefix(*aElement.Key, reservedTagKeyPrefix) {
+			continue
+		}
 		visitedIndexes = append(visitedIndexes, *aElement.Key)
 		for _, bElement := range a {
 			if equalStrings(aElement.Key, bElement.Key) {
@@ -128,6 +328,9 @@ mainLoop:
 		removed = append(removed, *aElement.Key)
 	}
 	for _, bElement := range a {
+		if strings.HasPrefix(*bElement.Key, reservedTagKeyPrefix) {
+			continue
+		}
 		if !ackutil.InStrings(*bElement.Key, visitedIndexes) {
 			added = append(added, bElement)
 		}
@@ -142,12 +345,8 @@ func (rm *resourceManager) getResourceTagsPagesWithContext(ctx context.Context,
 	exit := rlog.Trace("rm.getResourceTagsPagesWithContext")
 	defer exit(err)
 
-	tags := []*v1alpha1.Tag{}
-
-	var token *string = nil
-	for {
-		var listTagsOfResourceOutput *svcsdk.ListTagsOfResourceOutput
-		listTagsOfResourceOutput, err = rm.sdkapi.ListTagsOfResource(
+	return collectTagPages(func(token *string) (*svcsdk.ListTagsOfResourceOutput, error) {
+		listTagsOfResourceOutput, err := rm.sdkapi.ListTagsOfResource(
 			ctx,
 			&svcsdk.ListTagsOfResourceInput{
 				NextToken:   token,
@@ -155,6 +354,24 @@ func (rm *resourceManager) getResourceTagsPagesWithContext(ctx context.Context,
 			},
 		)
 		rm.metrics.RecordAPICall("GET", "ListTagsOfResource", err)
+		recordSDKCall("ListTagsOfResource", err)
+		return listTagsOfResourceOutput, err
+	})
+}
+
+// collectTagPages gathers the tags from every page returned by fetch,
+// following NextToken until the listing is exhausted. ListTagsOfResource
+// returns at most 100 tags per page, so the tag delta must be computed
+// against the full listing or tables with many tags would look like they
+// are missing tags on every reconcile.
+func collectTagPages(
+	fetch func(token *string) (*svcsdk.ListTagsOfResourceOutput, error),
+) ([]*v1alpha1.Tag, error) {
+	tags := []*v1alpha1.Tag{}
+
+	var token *string = nil
+	for {
+		listTagsOfResourceOutput, err := fetch(token)
 		if err != nil {
 			return nil, err
 		}
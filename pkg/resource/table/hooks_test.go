@@ -14,12 +14,25 @@
 package table
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	"github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	smithy "github.com/aws/smithy-go"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
 )
@@ -109,6 +122,38 @@ func Test_computeTagsDelta(t *testing.T) {
 			wantAdded:   []*v1alpha1.Tag{Tag2Updated, Tag3},
 			wantRemoved: []string{"k1"},
 		},
+		{
+			name: "keys are compared case-sensitively",
+			args: args{
+				a: []*v1alpha1.Tag{
+					{Key: aws.String("Env"), Value: aws.String("prod")},
+				},
+				b: []*v1alpha1.Tag{
+					{Key: aws.String("env"), Value: aws.String("prod")},
+				},
+			},
+			// DynamoDB tag keys are case-sensitive, so "Env" and "env" are
+			// two different tags.
+			wantAdded: []*v1alpha1.Tag{
+				{Key: aws.String("Env"), Value: aws.String("prod")},
+			},
+			wantRemoved: []string{"env"},
+		},
+		{
+			name: "aws: prefixed tags are ignored on both sides",
+			args: args{
+				a: []*v1alpha1.Tag{
+					Tag1,
+					{Key: aws.String("aws:servicecatalog:productArn"), Value: aws.String("arn")},
+				},
+				b: []*v1alpha1.Tag{
+					Tag1,
+					{Key: aws.String("aws:cloudformation:stack-name"), Value: aws.String("my-stack")},
+				},
+			},
+			wantAdded:   nil,
+			wantRemoved: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -123,6 +168,70 @@ func Test_computeTagsDelta(t *testing.T) {
 	}
 }
 
+func Test_collectTagPages(t *testing.T) {
+	// ListTagsOfResource caps each page at 100 tags; build a listing that
+	// spans two pages the way a table with ~130 tags comes back.
+	allTags := make([]svcsdktypes.Tag, 130)
+	for i := range allTags {
+		allTags[i] = svcsdktypes.Tag{
+			Key:   aws.String(fmt.Sprintf("key-%03d", i)),
+			Value: aws.String(fmt.Sprintf("value-%03d", i)),
+		}
+	}
+
+	t.Run("all pages are read before computing the tag delta", func(t *testing.T) {
+		var gotTokens []*string
+		got, err := collectTagPages(func(token *string) (*svcsdk.ListTagsOfResourceOutput, error) {
+			gotTokens = append(gotTokens, token)
+			if token == nil {
+				return &svcsdk.ListTagsOfResourceOutput{
+					Tags:      allTags[:100],
+					NextToken: aws.String("page-2"),
+				}, nil
+			}
+			return &svcsdk.ListTagsOfResourceOutput{Tags: allTags[100:]}, nil
+		})
+		if err != nil {
+			t.Fatalf("collectTagPages() error = %v, want nil", err)
+		}
+		if len(gotTokens) != 2 || gotTokens[0] != nil ||
+			gotTokens[1] == nil || *gotTokens[1] != "page-2" {
+			t.Errorf("collectTagPages() fetched tokens %v, want [nil page-2]", gotTokens)
+		}
+		if !reflect.DeepEqual(got, resourceTagsFromSDKTags(allTags)) {
+			t.Errorf("collectTagPages() returned %d tags, want %d", len(got), len(allTags))
+		}
+		// With the full listing in hand, a spec holding the same 130 tags
+		// produces an empty delta: no spurious TagResource calls.
+		added, removed := computeTagsDelta(resourceTagsFromSDKTags(allTags), got)
+		if len(added) != 0 || len(removed) != 0 {
+			t.Errorf(
+				"computeTagsDelta() over full listing = (%d added, %d removed), want no changes",
+				len(added), len(removed),
+			)
+		}
+	})
+
+	t.Run("a page error aborts the listing", func(t *testing.T) {
+		wantErr := errors.New("throttled")
+		got, err := collectTagPages(func(token *string) (*svcsdk.ListTagsOfResourceOutput, error) {
+			if token == nil {
+				return &svcsdk.ListTagsOfResourceOutput{
+					Tags:      allTags[:100],
+					NextToken: aws.String("page-2"),
+				}, nil
+			}
+			return nil, wantErr
+		})
+		if err != wantErr {
+			t.Errorf("collectTagPages() error = %v, want %v", err, wantErr)
+		}
+		if got != nil {
+			t.Errorf("collectTagPages() = %v, want nil on error", got)
+		}
+	})
+}
+
 func Test_customPreCompare(t *testing.T) {
 	t.Run("when billing mode is PAY_PER_REQUEST, ProvisionedThroughput should be nil", func(t *testing.T) {
 		a := &resource{ko: &v1alpha1.Table{
@@ -266,6 +375,175 @@ func Test_customPreCompare(t *testing.T) {
 		customPreCompare(delta, c, d)
 		require.False(t, delta.DifferentAt("Spec.GlobalSecondaryIndexes"))
 	})
+
+	t.Run("stale GSI throughput is ignored when billing mode is PAY_PER_REQUEST", func(t *testing.T) {
+		newGSI := func(pt *v1alpha1.ProvisionedThroughput) *v1alpha1.GlobalSecondaryIndex {
+			return &v1alpha1.GlobalSecondaryIndex{
+				IndexName: aws.String("index1"),
+				KeySchema: []*v1alpha1.KeySchemaElement{
+					{
+						AttributeName: aws.String("id"),
+						KeyType:       aws.String("HASH"),
+					},
+				},
+				Projection: &v1alpha1.Projection{
+					ProjectionType: aws.String("ALL"),
+				},
+				ProvisionedThroughput: pt,
+			}
+		}
+		// the desired spec still carries the throughput values used while the
+		// table was PROVISIONED; they must not yield a perpetual diff after
+		// the switch to PAY_PER_REQUEST
+		a := &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				BillingMode: aws.String(string(v1alpha1.BillingMode_PAY_PER_REQUEST)),
+				ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(5),
+					WriteCapacityUnits: aws.Int64(5),
+				},
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					newGSI(&v1alpha1.ProvisionedThroughput{
+						ReadCapacityUnits:  aws.Int64(5),
+						WriteCapacityUnits: aws.Int64(5),
+					}),
+				},
+			},
+		}}
+
+		b := &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				BillingMode:            aws.String(string(v1alpha1.BillingMode_PAY_PER_REQUEST)),
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{newGSI(nil)},
+			},
+		}}
+		delta := &compare.Delta{}
+		customPreCompare(delta, a, b)
+		require.False(t, delta.DifferentAt("Spec.GlobalSecondaryIndexes"))
+		require.Nil(t, a.ko.Spec.ProvisionedThroughput)
+		require.Nil(t, a.ko.Spec.GlobalSecondaryIndexes[0].ProvisionedThroughput)
+	})
+}
+
+func Test_newUpdateTablePayload_billingModeTransition(t *testing.T) {
+	rm := &resourceManager{}
+	newDelta := func() *compare.Delta {
+		delta := &compare.Delta{}
+		delta.Add("Spec.BillingMode", nil, nil)
+		return delta
+	}
+	newGSI := func(name string, pt *v1alpha1.ProvisionedThroughput) *v1alpha1.GlobalSecondaryIndex {
+		return &v1alpha1.GlobalSecondaryIndex{
+			IndexName:             aws.String(name),
+			ProvisionedThroughput: pt,
+		}
+	}
+
+	t.Run("switch to PROVISIONED without GSIs", func(t *testing.T) {
+		r := &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:   aws.String("table"),
+				BillingMode: aws.String(string(v1alpha1.BillingMode_PROVISIONED)),
+				ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(5),
+					WriteCapacityUnits: aws.Int64(10),
+				},
+			},
+		}}
+		input, err := rm.newUpdateTablePayload(context.TODO(), r, newDelta())
+		require.NoError(t, err)
+		require.Equal(t, svcsdktypes.BillingModeProvisioned, input.BillingMode)
+		require.NotNil(t, input.ProvisionedThroughput)
+		require.Equal(t, int64(5), *input.ProvisionedThroughput.ReadCapacityUnits)
+		require.Equal(t, int64(10), *input.ProvisionedThroughput.WriteCapacityUnits)
+		require.Empty(t, input.GlobalSecondaryIndexUpdates)
+	})
+
+	t.Run("switch to PROVISIONED updates every GSI in the same call", func(t *testing.T) {
+		r := &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:   aws.String("table"),
+				BillingMode: aws.String(string(v1alpha1.BillingMode_PROVISIONED)),
+				ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(5),
+					WriteCapacityUnits: aws.Int64(10),
+				},
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					newGSI("index1", &v1alpha1.ProvisionedThroughput{
+						ReadCapacityUnits:  aws.Int64(1),
+						WriteCapacityUnits: aws.Int64(2),
+					}),
+					newGSI("index2", &v1alpha1.ProvisionedThroughput{
+						ReadCapacityUnits:  aws.Int64(3),
+						WriteCapacityUnits: aws.Int64(4),
+					}),
+				},
+			},
+		}}
+		input, err := rm.newUpdateTablePayload(context.TODO(), r, newDelta())
+		require.NoError(t, err)
+		require.Len(t, input.GlobalSecondaryIndexUpdates, 2)
+		update := input.GlobalSecondaryIndexUpdates[0].Update
+		require.NotNil(t, update)
+		require.Equal(t, "index1", *update.IndexName)
+		require.Equal(t, int64(1), *update.ProvisionedThroughput.ReadCapacityUnits)
+		require.Equal(t, int64(2), *update.ProvisionedThroughput.WriteCapacityUnits)
+	})
+
+	t.Run("switch to PROVISIONED without table throughput is terminal", func(t *testing.T) {
+		r := &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:   aws.String("table"),
+				BillingMode: aws.String(string(v1alpha1.BillingMode_PROVISIONED)),
+			},
+		}}
+		_, err := rm.newUpdateTablePayload(context.TODO(), r, newDelta())
+		var termErr *ackerr.TerminalError
+		require.True(t, errors.As(err, &termErr))
+	})
+
+	t.Run("switch to PROVISIONED without GSI throughput is terminal", func(t *testing.T) {
+		r := &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:   aws.String("table"),
+				BillingMode: aws.String(string(v1alpha1.BillingMode_PROVISIONED)),
+				ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(5),
+					WriteCapacityUnits: aws.Int64(10),
+				},
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					newGSI("index1", nil),
+				},
+			},
+		}}
+		_, err := rm.newUpdateTablePayload(context.TODO(), r, newDelta())
+		var termErr *ackerr.TerminalError
+		require.True(t, errors.As(err, &termErr))
+	})
+
+	t.Run("switch to PAY_PER_REQUEST omits all throughput", func(t *testing.T) {
+		r := &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:   aws.String("table"),
+				BillingMode: aws.String(string(v1alpha1.BillingMode_PAY_PER_REQUEST)),
+				ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(5),
+					WriteCapacityUnits: aws.Int64(10),
+				},
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					newGSI("index1", &v1alpha1.ProvisionedThroughput{
+						ReadCapacityUnits:  aws.Int64(1),
+						WriteCapacityUnits: aws.Int64(2),
+					}),
+				},
+			},
+		}}
+		input, err := rm.newUpdateTablePayload(context.TODO(), r, newDelta())
+		require.NoError(t, err)
+		require.Equal(t, svcsdktypes.BillingModePayPerRequest, input.BillingMode)
+		require.Nil(t, input.ProvisionedThroughput)
+		require.Empty(t, input.GlobalSecondaryIndexUpdates)
+	})
 }
 
 func Test_newResourceDelta_customDeltaFunction_AttributeDefinitions(t *testing.T) {
@@ -324,6 +602,8 @@ func Test_newResourceDelta_customDeltaFunction_AttributeDefinitions(t *testing.T
 			want: false,
 		},
 		{
+			// server-side definitions with nothing in the spec are left
+			// alone; DynamoDB keeps definitions for deleted GSI keys around
 			name: "latest is not nil",
 			args: args{
 				a: &resource{
@@ -346,7 +626,7 @@ func Test_newResourceDelta_customDeltaFunction_AttributeDefinitions(t *testing.T
 					},
 				},
 			},
-			want: false,
+			want: true,
 		},
 		{
 			name: "desired and latest are equal",
@@ -425,6 +705,8 @@ func Test_newResourceDelta_customDeltaFunction_AttributeDefinitions(t *testing.T
 			want: false,
 		},
 		{
+			// the extra server-side definition is a leftover from a
+			// deleted GSI and must not produce a delta
 			name: "removed in desired",
 			args: args{
 				a: &resource{
@@ -456,7 +738,7 @@ func Test_newResourceDelta_customDeltaFunction_AttributeDefinitions(t *testing.T
 					},
 				},
 			},
-			want: false,
+			want: true,
 		},
 		{
 			name: "added in desired",
@@ -505,3 +787,2538 @@ func Test_newResourceDelta_customDeltaFunction_AttributeDefinitions(t *testing.T
 		})
 	}
 }
+
+func Test_streamViewTypeChangeRequiresRecreate(t *testing.T) {
+	// The decision is made from the observed stream alone so a reconcile
+	// resumed at any intermediate point of the disable/re-enable dance takes
+	// exactly the next step instead of repeating the previous one.
+	streamTable := func(spec *v1alpha1.StreamSpecification) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{StreamSpecification: spec},
+		}}
+	}
+	desired := streamTable(&v1alpha1.StreamSpecification{
+		StreamEnabled:  aws.Bool(true),
+		StreamViewType: aws.String("NEW_AND_OLD_IMAGES"),
+	})
+
+	t.Run("live stream with the wrong view type requires recreate", func(t *testing.T) {
+		latest := streamTable(&v1alpha1.StreamSpecification{
+			StreamEnabled:  aws.Bool(true),
+			StreamViewType: aws.String("KEYS_ONLY"),
+		})
+		require.True(t, streamViewTypeChangeRequiresRecreate(desired, latest))
+	})
+
+	t.Run("disabled stream is enabled directly, not recreated", func(t *testing.T) {
+		latest := streamTable(&v1alpha1.StreamSpecification{
+			StreamEnabled: aws.Bool(false),
+		})
+		require.False(t, streamViewTypeChangeRequiresRecreate(desired, latest))
+		require.False(t, streamViewTypeChangeRequiresRecreate(desired, streamTable(nil)))
+	})
+
+	t.Run("stream already carrying the desired view type is left alone", func(t *testing.T) {
+		latest := streamTable(&v1alpha1.StreamSpecification{
+			StreamEnabled:  aws.Bool(true),
+			StreamViewType: aws.String("NEW_AND_OLD_IMAGES"),
+		})
+		require.False(t, streamViewTypeChangeRequiresRecreate(desired, latest))
+		require.True(t, equalStreamSpecifications(desired.ko.Spec.StreamSpecification, latest.ko.Spec.StreamSpecification))
+	})
+
+	t.Run("enable-only change does not recreate", func(t *testing.T) {
+		enableOnly := streamTable(&v1alpha1.StreamSpecification{
+			StreamEnabled: aws.Bool(true),
+		})
+		latest := streamTable(&v1alpha1.StreamSpecification{
+			StreamEnabled: aws.Bool(true),
+		})
+		require.False(t, streamViewTypeChangeRequiresRecreate(enableOnly, latest))
+	})
+}
+
+func Test_customUpdateTable_staleStreamDeltaTakesNoAction(t *testing.T) {
+	// A controller restart between the stream re-enable and the status
+	// write leaves a delta that still lists the stream as different while
+	// the table already matches the spec. The reconcile must converge
+	// without issuing any call; rm has no SDK client wired up, so an
+	// UpdateTable attempt would panic.
+	rm := &resourceManager{}
+	streamSpec := &v1alpha1.StreamSpecification{
+		StreamEnabled:  aws.Bool(true),
+		StreamViewType: aws.String("NEW_AND_OLD_IMAGES"),
+	}
+	desired := &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{
+			TableName:           aws.String("table"),
+			StreamSpecification: streamSpec,
+		},
+	}}
+	latest := &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{
+			TableName:           aws.String("table"),
+			StreamSpecification: streamSpec,
+		},
+		Status: v1alpha1.TableStatus{TableStatus: aws.String("ACTIVE")},
+	}}
+	delta := compare.NewDelta()
+	delta.Add("Spec.StreamSpecification.StreamViewType", desired.ko.Spec.StreamSpecification.StreamViewType, aws.String("KEYS_ONLY"))
+
+	if _, err := rm.customUpdateTable(context.Background(), desired, latest, delta); err != requeueWaitWhileUpdating {
+		t.Fatalf("customUpdateTable() error = %v, want requeueWaitWhileUpdating", err)
+	}
+}
+
+func Test_equalKMSKeyIdentifiers(t *testing.T) {
+	keyARN := "arn:aws:kms:us-west-2:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+	tests := []struct {
+		name    string
+		desired string
+		latest  string
+		want    bool
+	}{
+		{
+			name:    "alias cannot be resolved and never drifts",
+			desired: "alias/dynamo-prod",
+			latest:  keyARN,
+			want:    true,
+		},
+		{
+			name:    "alias ARN cannot be resolved and never drifts",
+			desired: "arn:aws:kms:us-west-2:111122223333:alias/dynamo-prod",
+			latest:  keyARN,
+			want:    true,
+		},
+		{
+			name:    "matching key ARN",
+			desired: keyARN,
+			latest:  keyARN,
+			want:    true,
+		},
+		{
+			name:    "different key ARN",
+			desired: "arn:aws:kms:us-west-2:111122223333:key/other",
+			latest:  keyARN,
+			want:    false,
+		},
+		{
+			name:    "bare key id matching the ARN",
+			desired: "1234abcd-12ab-34cd-56ef-1234567890ab",
+			latest:  keyARN,
+			want:    true,
+		},
+		{
+			name:    "bare key id not matching the ARN",
+			desired: "0000dead-beef-34cd-56ef-1234567890ab",
+			latest:  keyARN,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := equalKMSKeyIdentifiers(aws.String(tt.desired), aws.String(tt.latest)); got != tt.want {
+				t.Errorf("equalKMSKeyIdentifiers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isTableBusyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "nil error",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "resource in use",
+			err:  &smithy.GenericAPIError{Code: "ResourceInUseException"},
+			want: true,
+		},
+		{
+			name: "limit exceeded",
+			err:  &smithy.GenericAPIError{Code: "LimitExceededException"},
+			want: true,
+		},
+		{
+			name: "validation error",
+			err:  &smithy.GenericAPIError{Code: "ValidationException"},
+			want: false,
+		},
+		{
+			name: "non-AWS error",
+			err:  errors.New("ResourceInUseException"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTableBusyError(tt.err); got != tt.want {
+				t.Errorf("isTableBusyError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isTagReadSoftError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "nil error",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "resource not found before ARN propagation",
+			err:  &smithy.GenericAPIError{Code: "ResourceNotFoundException"},
+			want: true,
+		},
+		{
+			name: "throttling",
+			err:  &smithy.GenericAPIError{Code: "ThrottlingException"},
+			want: true,
+		},
+		{
+			name: "rate limited",
+			err:  &smithy.GenericAPIError{Code: "LimitExceededException"},
+			want: true,
+		},
+		{
+			name: "access denied",
+			err:  &smithy.GenericAPIError{Code: "AccessDeniedException"},
+			want: false,
+		},
+		{
+			name: "non-AWS error",
+			err:  errors.New("connection reset"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTagReadSoftError(tt.err); got != tt.want {
+				t.Errorf("isTagReadSoftError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_customUpdateTable_waitsForActiveTable(t *testing.T) {
+	// rm has no SDK client wired up, so any attempt to issue a mutating call
+	// against the busy table would panic the test.
+	rm := &resourceManager{}
+	desired := &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{
+			TableName:                 aws.String("table"),
+			DeletionProtectionEnabled: aws.Bool(true),
+		},
+	}}
+	latest := &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{
+			TableName:                 aws.String("table"),
+			DeletionProtectionEnabled: aws.Bool(false),
+		},
+		Status: v1alpha1.TableStatus{
+			TableStatus: aws.String("UPDATING"),
+		},
+	}}
+	delta := compare.NewDelta()
+	delta.Add("Spec.DeletionProtectionEnabled", desired.ko.Spec.DeletionProtectionEnabled, latest.ko.Spec.DeletionProtectionEnabled)
+
+	// While the table is UPDATING the pending change must not be applied;
+	// the reconciler just requeues.
+	if _, err := rm.customUpdateTable(context.Background(), desired, latest, delta); err != requeueWaitWhileUpdating {
+		t.Fatalf("customUpdateTable() while UPDATING error = %v, want requeueWaitWhileUpdating", err)
+	}
+
+	// Once the table is ACTIVE and the change has been applied, the
+	// reconcile converges without issuing any further calls.
+	latest.ko.Status.TableStatus = aws.String("ACTIVE")
+	latest.ko.Spec.DeletionProtectionEnabled = aws.Bool(true)
+	if _, err := rm.customUpdateTable(context.Background(), desired, latest, compare.NewDelta()); err != nil {
+		t.Fatalf("customUpdateTable() after ACTIVE error = %v", err)
+	}
+}
+
+func Test_customPreCompare_shuffledAttributeDefinitions(t *testing.T) {
+	a := &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{
+			TableName: aws.String("table"),
+			AttributeDefinitions: []*v1alpha1.AttributeDefinition{
+				{AttributeName: aws.String("pk"), AttributeType: aws.String("S")},
+				{AttributeName: aws.String("sk"), AttributeType: aws.String("N")},
+			},
+			KeySchema: []*v1alpha1.KeySchemaElement{
+				{AttributeName: aws.String("pk"), KeyType: aws.String("HASH")},
+				{AttributeName: aws.String("sk"), KeyType: aws.String("RANGE")},
+			},
+		},
+	}}
+	b := &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{
+			TableName: aws.String("table"),
+			AttributeDefinitions: []*v1alpha1.AttributeDefinition{
+				{AttributeName: aws.String("sk"), AttributeType: aws.String("N")},
+				{AttributeName: aws.String("pk"), AttributeType: aws.String("S")},
+			},
+			KeySchema: []*v1alpha1.KeySchemaElement{
+				{AttributeName: aws.String("sk"), KeyType: aws.String("RANGE")},
+				{AttributeName: aws.String("pk"), KeyType: aws.String("HASH")},
+			},
+		},
+	}}
+	delta := compare.NewDelta()
+	customPreCompare(delta, a, b)
+	if delta.DifferentAt("Spec.AttributeDefinitions") {
+		t.Errorf("customPreCompare() reported delta for shuffled attribute definitions")
+	}
+	if delta.DifferentAt("Spec.KeySchema") {
+		t.Errorf("customPreCompare() reported delta for shuffled key schema")
+	}
+}
+
+func Test_customUpdateTable_immutableFields(t *testing.T) {
+	newTable := func() *v1alpha1.Table {
+		return &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName: aws.String("table"),
+			},
+			Status: v1alpha1.TableStatus{
+				TableStatus: aws.String("ACTIVE"),
+			},
+		}
+	}
+	tests := []struct {
+		name  string
+		paths []string
+	}{
+		{
+			name:  "table name",
+			paths: []string{"Spec.TableName"},
+		},
+		{
+			name:  "key schema",
+			paths: []string{"Spec.KeySchema"},
+		},
+		{
+			name:  "local secondary indexes",
+			paths: []string{"Spec.LocalSecondaryIndexes"},
+		},
+		{
+			name:  "all immutable fields at once",
+			paths: []string{"Spec.TableName", "Spec.KeySchema", "Spec.LocalSecondaryIndexes"},
+		},
+		{
+			// the mutable change must not be applied when the spec also
+			// carries an illegal change; rm has no SDK client wired up, so
+			// any attempted UpdateTable call would panic the test
+			name:  "immutable field combined with a mutable change",
+			paths: []string{"Spec.KeySchema", "Spec.DeletionProtectionEnabled"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := &resourceManager{}
+			desired := &resource{ko: newTable()}
+			latest := &resource{ko: newTable()}
+			delta := compare.NewDelta()
+			for _, path := range tt.paths {
+				delta.Add(path, nil, nil)
+			}
+			_, err := rm.customUpdateTable(context.Background(), desired, latest, delta)
+			var termErr *ackerr.TerminalError
+			if !errors.As(err, &termErr) {
+				t.Fatalf("customUpdateTable() error = %v, want terminal error", err)
+			}
+			for _, path := range tt.paths {
+				if path == "Spec.DeletionProtectionEnabled" {
+					continue
+				}
+				if !strings.Contains(err.Error(), path) {
+					t.Errorf("customUpdateTable() error %q does not name %s", err.Error(), path)
+				}
+			}
+		})
+	}
+}
+
+func Test_customPreCompare_ignoreProvisionedThroughputAnnotation(t *testing.T) {
+	newTable := func(rcu int64, projectionType string, annotation string) *resource {
+		ko := &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:   aws.String("table"),
+				BillingMode: aws.String(string(v1alpha1.BillingMode_PROVISIONED)),
+				ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(rcu),
+					WriteCapacityUnits: aws.Int64(rcu),
+				},
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					{
+						IndexName: aws.String("index1"),
+						KeySchema: []*v1alpha1.KeySchemaElement{
+							{AttributeName: aws.String("id"), KeyType: aws.String("HASH")},
+						},
+						Projection: &v1alpha1.Projection{
+							ProjectionType: aws.String(projectionType),
+						},
+						ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+							ReadCapacityUnits:  aws.Int64(rcu),
+							WriteCapacityUnits: aws.Int64(rcu),
+						},
+					},
+				},
+			},
+		}
+		if annotation != "" {
+			ko.ObjectMeta.Annotations = map[string]string{
+				AnnotationIgnoreProvisionedThroughput: annotation,
+			}
+		}
+		return &resource{ko: ko}
+	}
+
+	t.Run("throughput-only drift is ignored with the annotation", func(t *testing.T) {
+		a := newTable(5, "ALL", "table,gsi")
+		b := newTable(100, "ALL", "")
+		delta := newResourceDelta(a, b)
+		if delta.DifferentAt("Spec.ProvisionedThroughput") {
+			t.Errorf("unexpected delta at Spec.ProvisionedThroughput")
+		}
+		if delta.DifferentAt("Spec.GlobalSecondaryIndexes") {
+			t.Errorf("unexpected delta at Spec.GlobalSecondaryIndexes")
+		}
+	})
+
+	t.Run("throughput drift still counts without the annotation", func(t *testing.T) {
+		a := newTable(5, "ALL", "")
+		b := newTable(100, "ALL", "")
+		delta := newResourceDelta(a, b)
+		if !delta.DifferentAt("Spec.ProvisionedThroughput") {
+			t.Errorf("expected delta at Spec.ProvisionedThroughput")
+		}
+		if !delta.DifferentAt("Spec.GlobalSecondaryIndexes") {
+			t.Errorf("expected delta at Spec.GlobalSecondaryIndexes")
+		}
+	})
+
+	t.Run("non-throughput GSI changes still count with the annotation", func(t *testing.T) {
+		a := newTable(5, "KEYS_ONLY", "table,gsi")
+		b := newTable(100, "ALL", "")
+		delta := newResourceDelta(a, b)
+		if !delta.DifferentAt("Spec.GlobalSecondaryIndexes") {
+			t.Errorf("expected delta at Spec.GlobalSecondaryIndexes")
+		}
+	})
+
+	t.Run("table level only", func(t *testing.T) {
+		a := newTable(5, "ALL", "table")
+		b := newTable(100, "ALL", "")
+		delta := newResourceDelta(a, b)
+		if delta.DifferentAt("Spec.ProvisionedThroughput") {
+			t.Errorf("unexpected delta at Spec.ProvisionedThroughput")
+		}
+		if !delta.DifferentAt("Spec.GlobalSecondaryIndexes") {
+			t.Errorf("expected delta at Spec.GlobalSecondaryIndexes")
+		}
+	})
+}
+
+func Test_tagAPIError(t *testing.T) {
+	limitErr := &smithy.GenericAPIError{Code: "LimitExceededException", Message: "rate of requests exceeds the allowed throughput"}
+	if got := tagAPIError(limitErr); got != requeueWaitWhileTagging {
+		t.Errorf("tagAPIError() = %v, want requeueWaitWhileTagging", got)
+	}
+	otherErr := &smithy.GenericAPIError{Code: "ValidationException", Message: "bad tag"}
+	if got := tagAPIError(otherErr); got != otherErr {
+		t.Errorf("tagAPIError() = %v, want the original error", got)
+	}
+}
+
+func Test_replicaARN(t *testing.T) {
+	got, err := replicaARN("arn:aws:dynamodb:us-east-1:123456789012:table/orders", "eu-west-1")
+	if err != nil {
+		t.Fatalf("replicaARN() unexpected error: %v", err)
+	}
+	want := "arn:aws:dynamodb:eu-west-1:123456789012:table/orders"
+	if got != want {
+		t.Errorf("replicaARN() = %q, want %q", got, want)
+	}
+	if _, err := replicaARN("not-an-arn", "eu-west-1"); err == nil {
+		t.Errorf("expected an error for a malformed ARN")
+	}
+}
+
+func Test_hasAdoptOrCreatePolicy(t *testing.T) {
+	newTableWithAnnotations := func(annotations map[string]string) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			Spec:       v1alpha1.TableSpec{TableName: aws.String("table")},
+		}}
+	}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{
+			name:        "no annotations",
+			annotations: nil,
+			want:        false,
+		},
+		{
+			name: "adopt-or-create policy",
+			annotations: map[string]string{
+				"services.k8s.aws/adoption-policy": "adopt-or-create",
+			},
+			want: true,
+		},
+		{
+			name: "adopt policy",
+			annotations: map[string]string{
+				"services.k8s.aws/adoption-policy": "adopt",
+			},
+			want: false,
+		},
+		{
+			name: "unrecognized policy",
+			annotations: map[string]string{
+				"services.k8s.aws/adoption-policy": "create-only",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasAdoptOrCreatePolicy(newTableWithAnnotations(tt.annotations)); got != tt.want {
+				t.Errorf("hasAdoptOrCreatePolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isResourceInUseException(t *testing.T) {
+	inUseErr := &smithy.GenericAPIError{Code: "ResourceInUseException", Message: "Table already exists: table"}
+	if !isResourceInUseException(inUseErr) {
+		t.Errorf("isResourceInUseException() = false, want true")
+	}
+	if isResourceInUseException(&smithy.GenericAPIError{Code: "ValidationException"}) {
+		t.Errorf("isResourceInUseException() = true, want false")
+	}
+	if isResourceInUseException(errors.New("not an AWS error")) {
+		t.Errorf("isResourceInUseException() = true, want false")
+	}
+}
+
+func Test_isThrottlingError(t *testing.T) {
+	if !isThrottlingError(&smithy.GenericAPIError{Code: "LimitExceededException"}) {
+		t.Errorf("isThrottlingError(LimitExceededException) = false, want true")
+	}
+	if !isThrottlingError(&smithy.GenericAPIError{Code: "ThrottlingException"}) {
+		t.Errorf("isThrottlingError(ThrottlingException) = false, want true")
+	}
+	if isThrottlingError(&smithy.GenericAPIError{Code: "ResourceInUseException"}) {
+		t.Errorf("isThrottlingError(ResourceInUseException) = true, want false")
+	}
+	if isThrottlingError(errors.New("not an AWS error")) {
+		t.Errorf("isThrottlingError(non-AWS error) = true, want false")
+	}
+}
+
+func Test_throttleRequeue(t *testing.T) {
+	cause := &smithy.GenericAPIError{
+		Code:    "LimitExceededException",
+		Message: "Subscriber limit exceeded: There is a limit of 500 concurrent control plane operations",
+	}
+	for i := 0; i < 10; i++ {
+		err := throttleRequeue(cause)
+		var requeueErr *ackrequeue.RequeueNeededAfter
+		if !errors.As(err, &requeueErr) {
+			t.Fatalf("throttleRequeue() = %v, want a RequeueNeededAfter", err)
+		}
+		if !strings.Contains(requeueErr.Error(), "throttled by DynamoDB control plane") {
+			t.Errorf("throttleRequeue() message = %q, want it to note the control plane throttle", requeueErr.Error())
+		}
+		delay := requeueErr.Duration()
+		if delay < throttleRequeueBaseDelay || delay > throttleRequeueBaseDelay+throttleRequeueJitter {
+			t.Errorf("throttleRequeue() delay = %v, want between %v and %v",
+				delay, throttleRequeueBaseDelay, throttleRequeueBaseDelay+throttleRequeueJitter)
+		}
+	}
+}
+
+func Test_requeueIntervalOverride(t *testing.T) {
+	newTableWithAnnotations := func(annotations map[string]string) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			Spec:       v1alpha1.TableSpec{TableName: aws.String("table")},
+		}}
+	}
+	tests := []struct {
+		name         string
+		annotations  map[string]string
+		wantInterval time.Duration
+		wantOK       bool
+	}{
+		{
+			name:        "no annotations",
+			annotations: nil,
+			wantOK:      false,
+		},
+		{
+			name: "valid duration",
+			annotations: map[string]string{
+				AnnotationRequeueInterval: "120s",
+			},
+			wantInterval: 120 * time.Second,
+			wantOK:       true,
+		},
+		{
+			name: "unparseable duration",
+			annotations: map[string]string{
+				AnnotationRequeueInterval: "soon",
+			},
+			wantOK: false,
+		},
+		{
+			name: "negative duration",
+			annotations: map[string]string{
+				AnnotationRequeueInterval: "-10s",
+			},
+			wantOK: false,
+		},
+		{
+			name: "zero duration",
+			annotations: map[string]string{
+				AnnotationRequeueInterval: "0s",
+			},
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interval, ok := requeueIntervalOverride(newTableWithAnnotations(tt.annotations))
+			if ok != tt.wantOK {
+				t.Errorf("requeueIntervalOverride() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if interval != tt.wantInterval {
+				t.Errorf("requeueIntervalOverride() interval = %v, want %v", interval, tt.wantInterval)
+			}
+		})
+	}
+}
+
+func Test_overrideRequeueDelay(t *testing.T) {
+	withInterval := &resource{ko: &v1alpha1.Table{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			AnnotationRequeueInterval: "120s",
+		}},
+		Spec: v1alpha1.TableSpec{TableName: aws.String("table")},
+	}}
+	withoutInterval := &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{TableName: aws.String("table")},
+	}}
+
+	t.Run("no annotation passes the error through", func(t *testing.T) {
+		if got := overrideRequeueDelay(withoutInterval, requeueWaitWhileUpdating); got != requeueWaitWhileUpdating {
+			t.Errorf("overrideRequeueDelay() = %v, want requeueWaitWhileUpdating", got)
+		}
+	})
+	t.Run("wait-for-transition requeues pick up the annotation", func(t *testing.T) {
+		for _, sentinel := range []error{
+			requeueWaitWhileCreating,
+			requeueWaitWhileUpdating,
+			requeueWaitGSIReady,
+			requeueWaitReplicasActive,
+		} {
+			got := overrideRequeueDelay(withInterval, sentinel)
+			var requeueErr *ackrequeue.RequeueNeededAfter
+			if !errors.As(got, &requeueErr) {
+				t.Fatalf("overrideRequeueDelay(%v) = %v, want a requeue error", sentinel, got)
+			}
+			if requeueErr.Duration() != 120*time.Second {
+				t.Errorf("overrideRequeueDelay(%v) delay = %v, want 120s", sentinel, requeueErr.Duration())
+			}
+			if requeueErr.Unwrap().Error() != sentinel.(*ackrequeue.RequeueNeededAfter).Unwrap().Error() {
+				t.Errorf("overrideRequeueDelay(%v) changed the underlying cause", sentinel)
+			}
+		}
+	})
+	t.Run("other requeues keep their delay", func(t *testing.T) {
+		if got := overrideRequeueDelay(withInterval, requeueWaitTTLCooldown); got != requeueWaitTTLCooldown {
+			t.Errorf("overrideRequeueDelay() = %v, want requeueWaitTTLCooldown", got)
+		}
+	})
+	t.Run("non-requeue errors pass through", func(t *testing.T) {
+		err := errors.New("boom")
+		if got := overrideRequeueDelay(withInterval, err); got != err {
+			t.Errorf("overrideRequeueDelay() = %v, want the original error", got)
+		}
+	})
+}
+
+func Test_requiresSerializedUpdate(t *testing.T) {
+	// A spec change touching only the independent field group (tags, TTL,
+	// PITR, contributor insights, Kinesis destinations, resource policy) is
+	// applied in full within a single reconcile: no UpdateTable call is
+	// issued, so there is nothing to serialize or wait out.
+	independent := compare.NewDelta()
+	independent.Add("Spec.Tags", nil, nil)
+	independent.Add("Spec.TimeToLive", nil, nil)
+	independent.Add("Spec.ContinuousBackups", nil, nil)
+	independent.Add("Spec.ContributorInsights", nil, nil)
+	independent.Add("Spec.KinesisStreamDestinations", nil, nil)
+	independent.Add("Spec.ResourcePolicy", nil, nil)
+	if requiresSerializedUpdate(independent) {
+		t.Errorf("requiresSerializedUpdate() = true for a combined independent-only delta, want false")
+	}
+
+	// Mixing in an UpdateTable-proper change flips the delta into the
+	// serialized path.
+	combined := compare.NewDelta()
+	combined.Add("Spec.Tags", nil, nil)
+	combined.Add("Spec.TimeToLive", nil, nil)
+	combined.Add("Spec.ProvisionedThroughput", nil, nil)
+	if !requiresSerializedUpdate(combined) {
+		t.Errorf("requiresSerializedUpdate() = false for a delta with provisioned throughput changes, want true")
+	}
+}
+
+func Test_customPreCompare_gsiThroughputBillingMode(t *testing.T) {
+	// The same spec, carrying provisioned throughput on a GSI, must only
+	// produce a GSI delta when the effective billing mode is PROVISIONED.
+	newTable := func(billingMode string, rcu, wcu int64) *resource {
+		ko := &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName: aws.String("table"),
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					{
+						IndexName: aws.String("index1"),
+						KeySchema: []*v1alpha1.KeySchemaElement{
+							{AttributeName: aws.String("id"), KeyType: aws.String("HASH")},
+						},
+						Projection: &v1alpha1.Projection{ProjectionType: aws.String("ALL")},
+						ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+							ReadCapacityUnits:  aws.Int64(rcu),
+							WriteCapacityUnits: aws.Int64(wcu),
+						},
+					},
+				},
+			},
+		}
+		if billingMode != "" {
+			ko.Spec.BillingMode = aws.String(billingMode)
+		}
+		return &resource{ko: ko}
+	}
+
+	t.Run("stale throughput on an on-demand table is not a delta", func(t *testing.T) {
+		desired := newTable("PAY_PER_REQUEST", 5, 5)
+		latest := newTable("PAY_PER_REQUEST", 0, 0)
+		if delta := newResourceDelta(desired, latest); delta.DifferentAt("Spec.GlobalSecondaryIndexes") {
+			t.Errorf("GSI throughput produced a delta on an on-demand table")
+		}
+	})
+	t.Run("observed on-demand mode is inherited when the spec leaves it unset", func(t *testing.T) {
+		desired := newTable("", 5, 5)
+		latest := newTable("PAY_PER_REQUEST", 0, 0)
+		if delta := newResourceDelta(desired, latest); delta.DifferentAt("Spec.GlobalSecondaryIndexes") {
+			t.Errorf("GSI throughput produced a delta on an on-demand table with an unset spec billing mode")
+		}
+	})
+	t.Run("throughput change on a provisioned table is still a delta", func(t *testing.T) {
+		desired := newTable("PROVISIONED", 10, 10)
+		latest := newTable("PROVISIONED", 5, 5)
+		if delta := newResourceDelta(desired, latest); !delta.DifferentAt("Spec.GlobalSecondaryIndexes") {
+			t.Errorf("GSI throughput change did not produce a delta on a provisioned table")
+		}
+	})
+}
+
+func Test_customPreCompare_nilEmptyNormalization(t *testing.T) {
+	newTable := func(mutate func(spec *v1alpha1.TableSpec)) *resource {
+		ko := &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName: aws.String("table"),
+			},
+		}
+		if mutate != nil {
+			mutate(&ko.Spec)
+		}
+		return &resource{ko: ko}
+	}
+
+	t.Run("empty tag list does not untag an out-of-band tagged table", func(t *testing.T) {
+		desired := newTable(func(spec *v1alpha1.TableSpec) {
+			spec.Tags = []*v1alpha1.Tag{}
+		})
+		latest := newTable(func(spec *v1alpha1.TableSpec) {
+			spec.Tags = []*v1alpha1.Tag{Tag1}
+		})
+		if delta := newResourceDelta(desired, latest); delta.DifferentAt("Spec.Tags") {
+			t.Errorf("empty tag list produced a tags delta against an out-of-band tagged table")
+		}
+	})
+	t.Run("tags set in the spec are still managed", func(t *testing.T) {
+		desired := newTable(func(spec *v1alpha1.TableSpec) {
+			spec.Tags = []*v1alpha1.Tag{Tag1}
+		})
+		latest := newTable(nil)
+		if delta := newResourceDelta(desired, latest); !delta.DifferentAt("Spec.Tags") {
+			t.Errorf("tags set in the spec did not produce a tags delta")
+		}
+	})
+	t.Run("nil stream specification equals streamEnabled false", func(t *testing.T) {
+		desired := newTable(nil)
+		latest := newTable(func(spec *v1alpha1.TableSpec) {
+			spec.StreamSpecification = &v1alpha1.StreamSpecification{
+				StreamEnabled: aws.Bool(false),
+			}
+		})
+		if delta := newResourceDelta(desired, latest); delta.DifferentAt("Spec.StreamSpecification") {
+			t.Errorf("nil stream specification produced a delta against streamEnabled=false")
+		}
+	})
+	t.Run("enabling streams still produces a delta", func(t *testing.T) {
+		desired := newTable(func(spec *v1alpha1.TableSpec) {
+			spec.StreamSpecification = &v1alpha1.StreamSpecification{
+				StreamEnabled:  aws.Bool(true),
+				StreamViewType: aws.String("NEW_AND_OLD_IMAGES"),
+			}
+		})
+		latest := newTable(nil)
+		if delta := newResourceDelta(desired, latest); !delta.DifferentAt("Spec.StreamSpecification") {
+			t.Errorf("enabling streams did not produce a delta")
+		}
+	})
+	t.Run("nil sse specification equals the AWS owned key", func(t *testing.T) {
+		desired := newTable(nil)
+		latest := newTable(func(spec *v1alpha1.TableSpec) {
+			spec.SSESpecification = &v1alpha1.SSESpecification{
+				Enabled: aws.Bool(false),
+			}
+		})
+		if delta := newResourceDelta(desired, latest); delta.DifferentAt("Spec.SSESpecification") {
+			t.Errorf("nil sse specification produced a delta against enabled=false")
+		}
+	})
+	t.Run("enabling sse still produces a delta", func(t *testing.T) {
+		desired := newTable(func(spec *v1alpha1.TableSpec) {
+			spec.SSESpecification = &v1alpha1.SSESpecification{
+				Enabled: aws.Bool(true),
+			}
+		})
+		latest := newTable(nil)
+		if delta := newResourceDelta(desired, latest); !delta.DifferentAt("Spec.SSESpecification") {
+			t.Errorf("enabling sse did not produce a delta")
+		}
+	})
+	t.Run("empty index and replica lists equal nil", func(t *testing.T) {
+		desired := newTable(func(spec *v1alpha1.TableSpec) {
+			spec.GlobalSecondaryIndexes = []*v1alpha1.GlobalSecondaryIndex{}
+			spec.LocalSecondaryIndexes = []*v1alpha1.LocalSecondaryIndex{}
+			spec.TableReplicas = []*v1alpha1.CreateReplicationGroupMemberAction{}
+		})
+		latest := newTable(nil)
+		delta := newResourceDelta(desired, latest)
+		for _, path := range []string{
+			"Spec.GlobalSecondaryIndexes",
+			"Spec.LocalSecondaryIndexes",
+			"Spec.TableReplicas",
+		} {
+			if delta.DifferentAt(path) {
+				t.Errorf("empty collection produced a delta at %s", path)
+			}
+		}
+	})
+}
+
+func Test_sseTransitionMatrix(t *testing.T) {
+	const (
+		ownKeyARN     = "arn:aws:kms:us-west-2:111111111111:key/11111111-1111-1111-1111-111111111111"
+		managedKeyARN = "arn:aws:kms:us-west-2:111111111111:key/22222222-2222-2222-2222-222222222222"
+	)
+	newTable := func(sse *v1alpha1.SSESpecification, desc *v1alpha1.SSEDescription) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:        aws.String("table"),
+				SSESpecification: sse,
+			},
+			Status: v1alpha1.TableStatus{
+				SSEDescription: desc,
+			},
+		}}
+	}
+	// Server-side states as the read-one hook reports them: the AWS owned
+	// key shows up as no SSEDescription at all, while both the AWS managed
+	// key and a customer managed key report an ENABLED KMS description with
+	// the key's ARN.
+	serverOwned := func() (*v1alpha1.SSESpecification, *v1alpha1.SSEDescription) {
+		return nil, nil
+	}
+	serverKMS := func(keyARN string) (*v1alpha1.SSESpecification, *v1alpha1.SSEDescription) {
+		return &v1alpha1.SSESpecification{
+				Enabled:        aws.Bool(true),
+				SSEType:        aws.String("KMS"),
+				KMSMasterKeyID: aws.String(keyARN),
+			}, &v1alpha1.SSEDescription{
+				Status:          aws.String("ENABLED"),
+				SSEType:         aws.String("KMS"),
+				KMSMasterKeyARN: aws.String(keyARN),
+			}
+	}
+
+	tests := []struct {
+		name      string
+		desired   *v1alpha1.SSESpecification
+		latest    func() (*v1alpha1.SSESpecification, *v1alpha1.SSEDescription)
+		wantDelta bool
+	}{
+		{
+			name:      "nil spec on an AWS owned key table is in sync",
+			desired:   nil,
+			latest:    serverOwned,
+			wantDelta: false,
+		},
+		{
+			name: "enabling encryption with a key produces a delta",
+			desired: &v1alpha1.SSESpecification{
+				Enabled:        aws.Bool(true),
+				SSEType:        aws.String("KMS"),
+				KMSMasterKeyID: aws.String(ownKeyARN),
+			},
+			latest:    serverOwned,
+			wantDelta: true,
+		},
+		{
+			name:      "enabling encryption without a key produces a delta",
+			desired:   &v1alpha1.SSESpecification{Enabled: aws.Bool(true)},
+			latest:    serverOwned,
+			wantDelta: true,
+		},
+		{
+			name:      "enabled spec without a key is in sync with the AWS managed key",
+			desired:   &v1alpha1.SSESpecification{Enabled: aws.Bool(true)},
+			latest:    func() (*v1alpha1.SSESpecification, *v1alpha1.SSEDescription) { return serverKMS(managedKeyARN) },
+			wantDelta: false,
+		},
+		{
+			name: "enabled spec with the table's own key is in sync",
+			desired: &v1alpha1.SSESpecification{
+				Enabled:        aws.Bool(true),
+				SSEType:        aws.String("KMS"),
+				KMSMasterKeyID: aws.String(ownKeyARN),
+			},
+			latest:    func() (*v1alpha1.SSESpecification, *v1alpha1.SSEDescription) { return serverKMS(ownKeyARN) },
+			wantDelta: false,
+		},
+		{
+			name: "changing the key produces a delta",
+			desired: &v1alpha1.SSESpecification{
+				Enabled:        aws.Bool(true),
+				SSEType:        aws.String("KMS"),
+				KMSMasterKeyID: aws.String(managedKeyARN),
+			},
+			latest:    func() (*v1alpha1.SSESpecification, *v1alpha1.SSEDescription) { return serverKMS(ownKeyARN) },
+			wantDelta: true,
+		},
+		{
+			name:      "removing the spec from a KMS table produces a delta",
+			desired:   nil,
+			latest:    func() (*v1alpha1.SSESpecification, *v1alpha1.SSEDescription) { return serverKMS(ownKeyARN) },
+			wantDelta: true,
+		},
+		{
+			name:      "disabling encryption on a KMS table produces a delta",
+			desired:   &v1alpha1.SSESpecification{Enabled: aws.Bool(false)},
+			latest:    func() (*v1alpha1.SSESpecification, *v1alpha1.SSEDescription) { return serverKMS(ownKeyARN) },
+			wantDelta: true,
+		},
+		{
+			name:    "an in-flight encryption switch keeps the delta open",
+			desired: nil,
+			latest: func() (*v1alpha1.SSESpecification, *v1alpha1.SSEDescription) {
+				// During the switch back to the AWS owned key the derived
+				// Enabled flag already reads false, matching the spec; only
+				// SSEDescription still reports the transition.
+				return &v1alpha1.SSESpecification{Enabled: aws.Bool(false)},
+					&v1alpha1.SSEDescription{
+						Status:          aws.String("UPDATING"),
+						SSEType:         aws.String("KMS"),
+						KMSMasterKeyARN: aws.String(ownKeyARN),
+					}
+			},
+			wantDelta: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := newTable(tt.desired, nil)
+			latestSpec, latestDesc := tt.latest()
+			latest := newTable(latestSpec, latestDesc)
+			delta := newResourceDelta(desired, latest)
+			if got := delta.DifferentAt("Spec.SSESpecification"); got != tt.wantDelta {
+				t.Errorf("DifferentAt(Spec.SSESpecification) = %v, want %v", got, tt.wantDelta)
+			}
+		})
+	}
+}
+
+func Test_newUpdateTableSSESpecificationPayload(t *testing.T) {
+	keyARN := "arn:aws:kms:us-west-2:111111111111:key/11111111-1111-1111-1111-111111111111"
+	newTable := func(sse *v1alpha1.SSESpecification) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:        aws.String("table"),
+				SSESpecification: sse,
+			},
+		}}
+	}
+
+	t.Run("nil spec disables encryption", func(t *testing.T) {
+		input := newUpdateTableSSESpecificationPayload(newTable(nil))
+		if input.SSESpecification.Enabled == nil || *input.SSESpecification.Enabled {
+			t.Errorf("expected Enabled=false for a nil sseSpecification")
+		}
+	})
+	t.Run("enabled false disables encryption", func(t *testing.T) {
+		input := newUpdateTableSSESpecificationPayload(newTable(
+			&v1alpha1.SSESpecification{Enabled: aws.Bool(false)},
+		))
+		if input.SSESpecification.Enabled == nil || *input.SSESpecification.Enabled {
+			t.Errorf("expected Enabled=false for enabled=false")
+		}
+	})
+	t.Run("enabled with key carries the key and type", func(t *testing.T) {
+		input := newUpdateTableSSESpecificationPayload(newTable(
+			&v1alpha1.SSESpecification{
+				Enabled:        aws.Bool(true),
+				SSEType:        aws.String("KMS"),
+				KMSMasterKeyID: aws.String(keyARN),
+			},
+		))
+		if input.SSESpecification.Enabled == nil || !*input.SSESpecification.Enabled {
+			t.Fatalf("expected Enabled=true")
+		}
+		if input.SSESpecification.SSEType != svcsdktypes.SSETypeKms {
+			t.Errorf("SSEType = %q, want KMS", input.SSESpecification.SSEType)
+		}
+		if input.SSESpecification.KMSMasterKeyId == nil || *input.SSESpecification.KMSMasterKeyId != keyARN {
+			t.Errorf("KMSMasterKeyId = %v, want %s", input.SSESpecification.KMSMasterKeyId, keyARN)
+		}
+	})
+	t.Run("disabling drops the key and type", func(t *testing.T) {
+		input := newUpdateTableSSESpecificationPayload(newTable(
+			&v1alpha1.SSESpecification{
+				Enabled:        aws.Bool(false),
+				SSEType:        aws.String("KMS"),
+				KMSMasterKeyID: aws.String(keyARN),
+			},
+		))
+		if input.SSESpecification.Enabled == nil || *input.SSESpecification.Enabled {
+			t.Fatalf("expected Enabled=false")
+		}
+		if input.SSESpecification.KMSMasterKeyId != nil || input.SSESpecification.SSEType != "" {
+			t.Errorf("disabling must not carry a key or type")
+		}
+	})
+}
+
+func Test_customUpdateTable_waitsForSSESwitch(t *testing.T) {
+	// rm has no SDK client wired up, so any attempt to issue a mutating call
+	// against the switching table would panic the test.
+	rm := &resourceManager{}
+	newTable := func(sse *v1alpha1.SSESpecification, desc *v1alpha1.SSEDescription) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:        aws.String("table"),
+				SSESpecification: sse,
+			},
+			Status: v1alpha1.TableStatus{
+				TableStatus:    aws.String("ACTIVE"),
+				SSEDescription: desc,
+			},
+		}}
+	}
+
+	// While the switch back to the AWS owned key is applying, the table is
+	// ACTIVE but SSEDescription reports UPDATING; the reconciler requeues
+	// instead of issuing another UpdateTable.
+	desired := newTable(nil, nil)
+	latest := newTable(
+		&v1alpha1.SSESpecification{Enabled: aws.Bool(false)},
+		&v1alpha1.SSEDescription{
+			Status:  aws.String("UPDATING"),
+			SSEType: aws.String("KMS"),
+		},
+	)
+	delta := newResourceDelta(desired, latest)
+	if !delta.DifferentAt("Spec.SSESpecification") {
+		t.Fatalf("expected an open SSE delta while the switch is applying")
+	}
+	if _, err := rm.customUpdateTable(context.Background(), desired, latest, delta); err != requeueWaitSSEUpdating {
+		t.Fatalf("customUpdateTable() during SSE switch error = %v, want requeueWaitSSEUpdating", err)
+	}
+
+	// Once the switch has completed the delta closes and the reconcile
+	// converges without further calls.
+	desired = newTable(nil, nil)
+	latest = newTable(nil, nil)
+	if delta := newResourceDelta(desired, latest); delta.DifferentAt("Spec.SSESpecification") {
+		t.Fatalf("expected no SSE delta once the switch has completed")
+	}
+
+	// With the table settled, a pending switch back to the AWS owned key
+	// goes straight to UpdateTable, which panics here on the nil SDK
+	// client, proving the call is issued rather than silently skipped.
+	desired = newTable(nil, nil)
+	latest = newTable(
+		&v1alpha1.SSESpecification{
+			Enabled:        aws.Bool(true),
+			SSEType:        aws.String("KMS"),
+			KMSMasterKeyID: aws.String("arn:aws:kms:us-west-2:111111111111:key/11111111-1111-1111-1111-111111111111"),
+		},
+		&v1alpha1.SSEDescription{Status: aws.String("ENABLED")},
+	)
+	delta = newResourceDelta(desired, latest)
+	if !delta.DifferentAt("Spec.SSESpecification") {
+		t.Fatalf("expected a delta to switch the table back to the AWS owned key")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected customUpdateTable to reach UpdateTable for the switch")
+		}
+	}()
+	rm.customUpdateTable(context.Background(), desired, latest, delta)
+}
+
+func Test_validateTableSpecLimits(t *testing.T) {
+	gsiWithProjectedAttributes := func(name string, count int) *v1alpha1.GlobalSecondaryIndex {
+		gsi := &v1alpha1.GlobalSecondaryIndex{
+			IndexName: aws.String(name),
+			Projection: &v1alpha1.Projection{
+				ProjectionType: aws.String("INCLUDE"),
+			},
+		}
+		for i := 0; i < count; i++ {
+			gsi.Projection.NonKeyAttributes = append(
+				gsi.Projection.NonKeyAttributes,
+				aws.String(fmt.Sprintf("attr%d", i)),
+			)
+		}
+		return gsi
+	}
+	tests := []struct {
+		name    string
+		mutate  func(spec *v1alpha1.TableSpec)
+		wantErr string
+	}{
+		{
+			name:   "plain spec passes",
+			mutate: func(spec *v1alpha1.TableSpec) {},
+		},
+		{
+			name: "too many global secondary indexes",
+			mutate: func(spec *v1alpha1.TableSpec) {
+				for i := 0; i < maxGSIsPerTable+1; i++ {
+					spec.GlobalSecondaryIndexes = append(spec.GlobalSecondaryIndexes,
+						&v1alpha1.GlobalSecondaryIndex{IndexName: aws.String(fmt.Sprintf("gsi%d", i))})
+				}
+			},
+			wantErr: "21 global secondary indexes",
+		},
+		{
+			name: "too many local secondary indexes",
+			mutate: func(spec *v1alpha1.TableSpec) {
+				for i := 0; i < maxLSIsPerTable+1; i++ {
+					spec.LocalSecondaryIndexes = append(spec.LocalSecondaryIndexes,
+						&v1alpha1.LocalSecondaryIndex{
+							IndexName: aws.String(fmt.Sprintf("lsi%d", i)),
+							KeySchema: []*v1alpha1.KeySchemaElement{
+								{AttributeName: aws.String("sk"), KeyType: aws.String("RANGE")},
+							},
+						})
+				}
+			},
+			wantErr: "6 local secondary indexes",
+		},
+		{
+			name: "invalid key attribute type",
+			mutate: func(spec *v1alpha1.TableSpec) {
+				spec.AttributeDefinitions = []*v1alpha1.AttributeDefinition{
+					{AttributeName: aws.String("id"), AttributeType: aws.String("BOOL")},
+				}
+			},
+			wantErr: `attribute "id" has type "BOOL"`,
+		},
+		{
+			name: "too many projected non-key attributes across indexes",
+			mutate: func(spec *v1alpha1.TableSpec) {
+				spec.GlobalSecondaryIndexes = []*v1alpha1.GlobalSecondaryIndex{
+					gsiWithProjectedAttributes("gsi1", 60),
+					gsiWithProjectedAttributes("gsi2", 41),
+				}
+			},
+			wantErr: "101 non-key attributes",
+		},
+		{
+			name: "local secondary index without a range key",
+			mutate: func(spec *v1alpha1.TableSpec) {
+				spec.LocalSecondaryIndexes = []*v1alpha1.LocalSecondaryIndex{
+					{
+						IndexName: aws.String("lsi1"),
+						KeySchema: []*v1alpha1.KeySchemaElement{
+							{AttributeName: aws.String("id"), KeyType: aws.String("HASH")},
+						},
+					},
+				}
+			},
+			wantErr: `local secondary index "lsi1" must declare a RANGE key`,
+		},
+		{
+			name: "local secondary index with a valid key schema passes",
+			mutate: func(spec *v1alpha1.TableSpec) {
+				spec.KeySchema = []*v1alpha1.KeySchemaElement{
+					{AttributeName: aws.String("id"), KeyType: aws.String("HASH")},
+				}
+				spec.AttributeDefinitions = []*v1alpha1.AttributeDefinition{
+					{AttributeName: aws.String("id"), AttributeType: aws.String("S")},
+					{AttributeName: aws.String("sk"), AttributeType: aws.String("S")},
+				}
+				spec.LocalSecondaryIndexes = []*v1alpha1.LocalSecondaryIndex{
+					{
+						IndexName: aws.String("lsi1"),
+						KeySchema: []*v1alpha1.KeySchemaElement{
+							{AttributeName: aws.String("id"), KeyType: aws.String("HASH")},
+							{AttributeName: aws.String("sk"), KeyType: aws.String("RANGE")},
+						},
+					},
+				}
+			},
+		},
+		{
+			name: "local secondary index with a different hash key",
+			mutate: func(spec *v1alpha1.TableSpec) {
+				spec.KeySchema = []*v1alpha1.KeySchemaElement{
+					{AttributeName: aws.String("id"), KeyType: aws.String("HASH")},
+				}
+				spec.AttributeDefinitions = []*v1alpha1.AttributeDefinition{
+					{AttributeName: aws.String("id"), AttributeType: aws.String("S")},
+					{AttributeName: aws.String("sk"), AttributeType: aws.String("S")},
+				}
+				spec.LocalSecondaryIndexes = []*v1alpha1.LocalSecondaryIndex{
+					{
+						IndexName: aws.String("lsi1"),
+						KeySchema: []*v1alpha1.KeySchemaElement{
+							{AttributeName: aws.String("other"), KeyType: aws.String("HASH")},
+							{AttributeName: aws.String("sk"), KeyType: aws.String("RANGE")},
+						},
+					},
+				}
+			},
+			wantErr: `local secondary index "lsi1" must use the table's hash key "id"`,
+		},
+		{
+			name: "local secondary index range key attribute not declared",
+			mutate: func(spec *v1alpha1.TableSpec) {
+				spec.KeySchema = []*v1alpha1.KeySchemaElement{
+					{AttributeName: aws.String("id"), KeyType: aws.String("HASH")},
+				}
+				spec.AttributeDefinitions = []*v1alpha1.AttributeDefinition{
+					{AttributeName: aws.String("id"), AttributeType: aws.String("S")},
+				}
+				spec.LocalSecondaryIndexes = []*v1alpha1.LocalSecondaryIndex{
+					{
+						IndexName: aws.String("lsi1"),
+						KeySchema: []*v1alpha1.KeySchemaElement{
+							{AttributeName: aws.String("id"), KeyType: aws.String("HASH")},
+							{AttributeName: aws.String("sk"), KeyType: aws.String("RANGE")},
+						},
+					},
+				}
+			},
+			wantErr: `local secondary index "lsi1" range key attribute "sk" is not declared`,
+		},
+		{
+			name: "table key schema with two hash keys",
+			mutate: func(spec *v1alpha1.TableSpec) {
+				spec.KeySchema = []*v1alpha1.KeySchemaElement{
+					{AttributeName: aws.String("id"), KeyType: aws.String("HASH")},
+					{AttributeName: aws.String("other"), KeyType: aws.String("HASH")},
+				}
+			},
+			wantErr: "table declares more than one HASH key",
+		},
+		{
+			name: "global secondary index with two range keys",
+			mutate: func(spec *v1alpha1.TableSpec) {
+				spec.GlobalSecondaryIndexes = []*v1alpha1.GlobalSecondaryIndex{
+					{
+						IndexName: aws.String("gsi1"),
+						KeySchema: []*v1alpha1.KeySchemaElement{
+							{AttributeName: aws.String("pk"), KeyType: aws.String("HASH")},
+							{AttributeName: aws.String("sk"), KeyType: aws.String("RANGE")},
+							{AttributeName: aws.String("sk2"), KeyType: aws.String("RANGE")},
+						},
+					},
+				}
+			},
+			wantErr: `global secondary index "gsi1" declares more than one RANGE key`,
+		},
+		{
+			name: "all missing key attributes reported in one message",
+			mutate: func(spec *v1alpha1.TableSpec) {
+				spec.AttributeDefinitions = []*v1alpha1.AttributeDefinition{
+					{AttributeName: aws.String("id"), AttributeType: aws.String("S")},
+				}
+				spec.KeySchema = []*v1alpha1.KeySchemaElement{
+					{AttributeName: aws.String("id"), KeyType: aws.String("HASH")},
+				}
+				spec.GlobalSecondaryIndexes = []*v1alpha1.GlobalSecondaryIndex{
+					{
+						IndexName: aws.String("gsi1"),
+						KeySchema: []*v1alpha1.KeySchemaElement{
+							{AttributeName: aws.String("email"), KeyType: aws.String("HASH")},
+						},
+					},
+					{
+						IndexName: aws.String("gsi2"),
+						KeySchema: []*v1alpha1.KeySchemaElement{
+							{AttributeName: aws.String("created_at"), KeyType: aws.String("HASH")},
+						},
+					},
+				}
+			},
+			wantErr: "key attributes not declared in attributeDefinitions: created_at, email",
+		},
+		{
+			name: "unused attribute definitions are flagged",
+			mutate: func(spec *v1alpha1.TableSpec) {
+				spec.AttributeDefinitions = []*v1alpha1.AttributeDefinition{
+					{AttributeName: aws.String("id"), AttributeType: aws.String("S")},
+					{AttributeName: aws.String("orphan"), AttributeType: aws.String("N")},
+				}
+				spec.KeySchema = []*v1alpha1.KeySchemaElement{
+					{AttributeName: aws.String("id"), KeyType: aws.String("HASH")},
+				}
+			},
+			wantErr: "attribute definitions not used by any key schema: orphan",
+		},
+		{
+			name: "fully covered key schemas pass",
+			mutate: func(spec *v1alpha1.TableSpec) {
+				spec.AttributeDefinitions = []*v1alpha1.AttributeDefinition{
+					{AttributeName: aws.String("id"), AttributeType: aws.String("S")},
+					{AttributeName: aws.String("email"), AttributeType: aws.String("S")},
+				}
+				spec.KeySchema = []*v1alpha1.KeySchemaElement{
+					{AttributeName: aws.String("id"), KeyType: aws.String("HASH")},
+				}
+				spec.GlobalSecondaryIndexes = []*v1alpha1.GlobalSecondaryIndex{
+					{
+						IndexName: aws.String("gsi1"),
+						KeySchema: []*v1alpha1.KeySchemaElement{
+							{AttributeName: aws.String("email"), KeyType: aws.String("HASH")},
+						},
+					},
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ko := &v1alpha1.Table{
+				Spec: v1alpha1.TableSpec{TableName: aws.String("table")},
+			}
+			tt.mutate(&ko.Spec)
+			err := validateTableSpecLimits(&resource{ko: ko})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateTableSpecLimits() error = %v, want nil", err)
+				}
+				return
+			}
+			var termErr *ackerr.TerminalError
+			if !errors.As(err, &termErr) {
+				t.Fatalf("validateTableSpecLimits() error = %v, want a terminal error", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateTableSpecLimits() error %q does not contain %q", err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateMultiRegionConsistency(t *testing.T) {
+	replicas := func(regions ...string) []*v1alpha1.CreateReplicationGroupMemberAction {
+		out := []*v1alpha1.CreateReplicationGroupMemberAction{}
+		for _, region := range regions {
+			out = append(out, &v1alpha1.CreateReplicationGroupMemberAction{
+				RegionName: aws.String(region),
+			})
+		}
+		return out
+	}
+	tests := []struct {
+		name        string
+		mrc         *string
+		replicas    []*v1alpha1.CreateReplicationGroupMemberAction
+		observedMRC *string
+		wantErr     string
+	}{
+		{
+			name: "unset consistency mode passes",
+		},
+		{
+			name:     "eventual consistency passes",
+			mrc:      aws.String("EVENTUAL"),
+			replicas: replicas("us-west-2"),
+		},
+		{
+			name:     "strong consistency with two replicas passes",
+			mrc:      aws.String("STRONG"),
+			replicas: replicas("us-west-2", "us-east-2"),
+		},
+		{
+			name:     "strong consistency with one replica goes terminal",
+			mrc:      aws.String("STRONG"),
+			replicas: replicas("us-west-2"),
+			wantErr:  "requires exactly two replicas",
+		},
+		{
+			name:    "unknown consistency mode goes terminal",
+			mrc:     aws.String("STRONGEST"),
+			wantErr: `must be "EVENTUAL" or "STRONG"`,
+		},
+		{
+			name:        "changing the observed mode goes terminal",
+			mrc:         aws.String("STRONG"),
+			replicas:    replicas("us-west-2", "us-east-2"),
+			observedMRC: aws.String("EVENTUAL"),
+			wantErr:     "cannot be changed once the global table",
+		},
+		{
+			name:        "matching the observed mode passes",
+			mrc:         aws.String("STRONG"),
+			replicas:    replicas("us-west-2", "us-east-2"),
+			observedMRC: aws.String("STRONG"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := &resource{ko: &v1alpha1.Table{
+				Spec: v1alpha1.TableSpec{
+					TableName:              aws.String("table"),
+					MultiRegionConsistency: tt.mrc,
+					TableReplicas:          tt.replicas,
+				},
+			}}
+			latest := &resource{ko: &v1alpha1.Table{
+				Status: v1alpha1.TableStatus{
+					MultiRegionConsistency: tt.observedMRC,
+				},
+			}}
+			err := validateMultiRegionConsistency(desired, latest)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateMultiRegionConsistency() error = %v, want nil", err)
+				}
+				return
+			}
+			var termErr *ackerr.TerminalError
+			if !errors.As(err, &termErr) {
+				t.Fatalf("validateMultiRegionConsistency() error = %v, want a terminal error", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateMultiRegionConsistency() error %q does not contain %q", err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_sdkDelete_deletionPolicyRetain(t *testing.T) {
+	// rm has no SDK client wired up, so reaching DeleteTable would panic the
+	// test; returning cleanly proves the retain guard short-circuited the
+	// delete.
+	rm := &resourceManager{}
+	arn := ackv1alpha1.AWSResourceName("arn:aws:dynamodb:us-west-2:111122223333:table/retained")
+	retained := &resource{ko: &v1alpha1.Table{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			ackv1alpha1.AnnotationDeletionPolicy: string(ackv1alpha1.DeletionPolicyRetain),
+		}},
+		Spec: v1alpha1.TableSpec{TableName: aws.String("retained")},
+		Status: v1alpha1.TableStatus{
+			ACKResourceMetadata: &ackv1alpha1.ResourceMetadata{ARN: &arn},
+			TableStatus:         aws.String("ACTIVE"),
+		},
+	}}
+	latest, err := rm.sdkDelete(context.Background(), retained)
+	if err != nil {
+		t.Fatalf("sdkDelete() with retain policy error = %v, want nil", err)
+	}
+	if latest != nil {
+		t.Fatalf("sdkDelete() with retain policy latest = %v, want nil", latest)
+	}
+
+	// Without the retain policy the delete path must still run: a table in
+	// DELETING state reports its usual requeue instead of being orphaned.
+	deleting := &resource{ko: &v1alpha1.Table{
+		Spec:   v1alpha1.TableSpec{TableName: aws.String("deleting")},
+		Status: v1alpha1.TableStatus{TableStatus: aws.String("DELETING")},
+	}}
+	if _, err := rm.sdkDelete(context.Background(), deleting); err != requeueWaitWhileDeleting {
+		t.Fatalf("sdkDelete() without retain policy error = %v, want requeueWaitWhileDeleting", err)
+	}
+}
+
+func Test_tableReadiness_staggeredTransitions(t *testing.T) {
+	// Readiness requires the table, every GSI and every replica to be
+	// ACTIVE; walk a table through the staggered transitions and check
+	// which gate holds at each stage.
+	r := &resource{ko: &v1alpha1.Table{
+		Status: v1alpha1.TableStatus{TableStatus: aws.String("CREATING")},
+	}}
+	if !isTableCreating(r) {
+		t.Fatal("expected the table gate to hold while the table is CREATING")
+	}
+
+	// Table ACTIVE, new GSI still backfilling: the GSI gate holds; no
+	// replicas exist yet, so the replica gate does not.
+	r.ko.Status.TableStatus = aws.String("ACTIVE")
+	r.ko.Status.GlobalSecondaryIndexesDescriptions = []*v1alpha1.GlobalSecondaryIndexDescription{
+		{IndexName: aws.String("email-index"), IndexStatus: aws.String("CREATING")},
+	}
+	if isTableCreating(r) {
+		t.Fatal("table gate must release once the table is ACTIVE")
+	}
+	if canUpdateTableGSIs(r) {
+		t.Fatal("expected the GSI gate to hold while the index is CREATING")
+	}
+	if tableReplicasTransitioning(r) {
+		t.Fatal("replica gate must not hold without replicas")
+	}
+
+	// GSI ACTIVE, replica still CREATING: only the replica gate holds.
+	r.ko.Status.GlobalSecondaryIndexesDescriptions[0].IndexStatus = aws.String("ACTIVE")
+	r.ko.Status.Replicas = []*v1alpha1.ReplicaDescription{
+		{RegionName: aws.String("us-east-1"), ReplicaStatus: aws.String("CREATING")},
+		{RegionName: aws.String("us-west-2"), ReplicaStatus: aws.String("ACTIVE")},
+	}
+	if !canUpdateTableGSIs(r) {
+		t.Fatal("GSI gate must release once the index is ACTIVE")
+	}
+	if !tableReplicasTransitioning(r) {
+		t.Fatal("expected the replica gate to hold while a replica is CREATING")
+	}
+
+	// Everything ACTIVE: no gate left, the resource can be reported synced.
+	r.ko.Status.Replicas[0].ReplicaStatus = aws.String("ACTIVE")
+	if isTableCreating(r) || !canUpdateTableGSIs(r) || tableReplicasTransitioning(r) {
+		t.Fatal("expected all gates to release once everything is ACTIVE")
+	}
+}
+
+func Test_customUpdateTable_driftRemediationObserve(t *testing.T) {
+	// rm has no SDK client wired up, so any mutating call would panic the
+	// test; in observe mode a non-empty delta must be reported without a
+	// single API call.
+	rm := &resourceManager{}
+	desired := &resource{ko: &v1alpha1.Table{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			AnnotationDriftRemediation: "observe",
+		}},
+		Spec: v1alpha1.TableSpec{
+			TableName:   aws.String("table"),
+			BillingMode: aws.String("PAY_PER_REQUEST"),
+		},
+	}}
+	latest := &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{
+			TableName:   aws.String("table"),
+			BillingMode: aws.String("PROVISIONED"),
+		},
+		Status: v1alpha1.TableStatus{TableStatus: aws.String("ACTIVE")},
+	}}
+	delta := compare.NewDelta()
+	delta.Add("Spec.BillingMode", desired.ko.Spec.BillingMode, latest.ko.Spec.BillingMode)
+
+	updated, err := rm.customUpdateTable(context.Background(), desired, latest, delta)
+	if err != nil {
+		t.Fatalf("customUpdateTable() in observe mode error = %v, want nil", err)
+	}
+	if updated != desired {
+		t.Fatalf("customUpdateTable() in observe mode must return the desired resource")
+	}
+	cond := getSyncedCondition(desired)
+	if cond == nil || cond.Status != corev1.ConditionFalse {
+		t.Fatalf("expected the synced condition to be False, got %+v", cond)
+	}
+	if cond.Message == nil || !strings.Contains(*cond.Message, "Spec.BillingMode") {
+		t.Errorf("expected the condition message to name the drifted field, got %v", cond.Message)
+	}
+
+	// Without the annotation remediation resumes: the same delta against a
+	// busy table reaches the normal update flow, which requeues.
+	desired.ko.ObjectMeta.Annotations = nil
+	latest.ko.Status.TableStatus = aws.String("UPDATING")
+	if _, err := rm.customUpdateTable(context.Background(), desired, latest, delta); err != requeueWaitWhileUpdating {
+		t.Fatalf("customUpdateTable() without the annotation error = %v, want requeueWaitWhileUpdating", err)
+	}
+}
+
+func Test_defaultTableName(t *testing.T) {
+	t.Run("an omitted table name defaults to the object name", func(t *testing.T) {
+		r := &resource{ko: &v1alpha1.Table{
+			ObjectMeta: metav1.ObjectMeta{Name: "orders"},
+		}}
+		defaultTableName(r)
+		if r.ko.Spec.TableName == nil || *r.ko.Spec.TableName != "orders" {
+			t.Errorf("Spec.TableName = %v, want %q", r.ko.Spec.TableName, "orders")
+		}
+	})
+
+	t.Run("an explicit table name is kept", func(t *testing.T) {
+		r := &resource{ko: &v1alpha1.Table{
+			ObjectMeta: metav1.ObjectMeta{Name: "orders"},
+			Spec:       v1alpha1.TableSpec{TableName: aws.String("orders-prod")},
+		}}
+		defaultTableName(r)
+		if *r.ko.Spec.TableName != "orders-prod" {
+			t.Errorf("Spec.TableName = %q, want %q", *r.ko.Spec.TableName, "orders-prod")
+		}
+	})
+
+	t.Run("a defaulted name does not register as a rename", func(t *testing.T) {
+		a := &resource{ko: &v1alpha1.Table{
+			ObjectMeta: metav1.ObjectMeta{Name: "orders"},
+		}}
+		b := &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{TableName: aws.String("orders")},
+		}}
+		if delta := newResourceDelta(a, b); delta.DifferentAt("Spec.TableName") {
+			t.Errorf("delta reports a Spec.TableName difference: %v", delta.Differences)
+		}
+	})
+
+	t.Run("renaming after creation goes terminal", func(t *testing.T) {
+		rm := &resourceManager{}
+		desired := &resource{ko: &v1alpha1.Table{
+			ObjectMeta: metav1.ObjectMeta{Name: "orders"},
+			Spec:       v1alpha1.TableSpec{TableName: aws.String("orders-renamed")},
+		}}
+		latest := &resource{ko: &v1alpha1.Table{
+			Spec:   v1alpha1.TableSpec{TableName: aws.String("orders")},
+			Status: v1alpha1.TableStatus{TableStatus: aws.String("ACTIVE")},
+		}}
+		delta := newResourceDelta(desired, latest)
+		if !delta.DifferentAt("Spec.TableName") {
+			t.Fatalf("expected a Spec.TableName difference")
+		}
+		_, err := rm.customUpdateTable(context.Background(), desired, latest, delta)
+		var termErr *ackerr.TerminalError
+		if !errors.As(err, &termErr) {
+			t.Fatalf("customUpdateTable() error = %v, want a terminal error", err)
+		}
+		if !strings.Contains(err.Error(), "Spec.TableName") {
+			t.Errorf("terminal error %q does not name Spec.TableName", err.Error())
+		}
+	})
+}
+
+func Test_normalizeKeySchema(t *testing.T) {
+	ks := func(name, keyType string) *v1alpha1.KeySchemaElement {
+		return &v1alpha1.KeySchemaElement{AttributeName: aws.String(name), KeyType: aws.String(keyType)}
+	}
+
+	reversed := []*v1alpha1.KeySchemaElement{ks("sk", "RANGE"), ks("pk", "HASH")}
+	normalizeKeySchema(reversed)
+	if *reversed[0].KeyType != "HASH" || *reversed[1].KeyType != "RANGE" {
+		t.Errorf("normalizeKeySchema() did not order HASH before RANGE: %v, %v",
+			*reversed[0].KeyType, *reversed[1].KeyType)
+	}
+
+	ordered := []*v1alpha1.KeySchemaElement{ks("pk", "HASH"), ks("sk", "RANGE")}
+	normalizeKeySchema(ordered)
+	if *ordered[0].AttributeName != "pk" || *ordered[1].AttributeName != "sk" {
+		t.Errorf("normalizeKeySchema() reordered an already canonical schema")
+	}
+}
+
+func Test_customPreCompare_reversedKeySchema(t *testing.T) {
+	// A spec written RANGE-first is accepted by CreateTable, but
+	// DescribeTable reports the HASH key first; the comparison must not see
+	// that as a difference, on the table or on its indexes.
+	newTable := func(tableKS, gsiKS []*v1alpha1.KeySchemaElement) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName: aws.String("orders"),
+				KeySchema: tableKS,
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					{IndexName: aws.String("gsi1"), KeySchema: gsiKS},
+				},
+			},
+		}}
+	}
+	ks := func(name, keyType string) *v1alpha1.KeySchemaElement {
+		return &v1alpha1.KeySchemaElement{AttributeName: aws.String(name), KeyType: aws.String(keyType)}
+	}
+
+	a := newTable(
+		[]*v1alpha1.KeySchemaElement{ks("sk", "RANGE"), ks("pk", "HASH")},
+		[]*v1alpha1.KeySchemaElement{ks("gsk", "RANGE"), ks("gpk", "HASH")},
+	)
+	b := newTable(
+		[]*v1alpha1.KeySchemaElement{ks("pk", "HASH"), ks("sk", "RANGE")},
+		[]*v1alpha1.KeySchemaElement{ks("gpk", "HASH"), ks("gsk", "RANGE")},
+	)
+	delta := newResourceDelta(a, b)
+	for _, path := range []string{"Spec.KeySchema", "Spec.GlobalSecondaryIndexes"} {
+		if delta.DifferentAt(path) {
+			t.Errorf("a reversed key schema produced a delta at %s", path)
+		}
+	}
+}
+
+func Test_customUpdateTable_archivedTable(t *testing.T) {
+	// rm has no SDK client wired up, so any attempt to mutate the archived
+	// table would panic the test.
+	rm := &resourceManager{}
+	desired := &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{
+			TableName:                 aws.String("archived"),
+			DeletionProtectionEnabled: aws.Bool(true),
+		},
+	}}
+	latest := &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{TableName: aws.String("archived")},
+		Status: v1alpha1.TableStatus{
+			TableStatus: aws.String("ARCHIVED"),
+			ArchivalSummary: &v1alpha1.ArchivalSummary{
+				ArchivalReason:    aws.String("INACCESSIBLE_ENCRYPTION_CREDENTIALS"),
+				ArchivalBackupARN: aws.String("arn:aws:dynamodb:us-west-2:111122223333:table/archived/backup/01"),
+			},
+		},
+	}}
+	delta := compare.NewDelta()
+	delta.Add("Spec.DeletionProtectionEnabled", desired.ko.Spec.DeletionProtectionEnabled, nil)
+
+	if _, err := rm.customUpdateTable(context.Background(), desired, latest, delta); err != nil {
+		t.Fatalf("customUpdateTable() against an ARCHIVED table error = %v, want nil", err)
+	}
+	cond := getTerminalCondition(desired)
+	if cond == nil || cond.Status != corev1.ConditionTrue {
+		t.Fatalf("customUpdateTable() against an ARCHIVED table did not set the terminal condition")
+	}
+	for _, want := range []string{
+		"'ARCHIVED' status",
+		"INACCESSIBLE_ENCRYPTION_CREDENTIALS",
+		"arn:aws:dynamodb:us-west-2:111122223333:table/archived/backup/01",
+	} {
+		if !strings.Contains(*cond.Message, want) {
+			t.Errorf("terminal condition message %q does not mention %q", *cond.Message, want)
+		}
+	}
+}
+
+func Test_sdkDelete_archivedTable(t *testing.T) {
+	// rm has no SDK client wired up, so reaching DeleteTable would panic the
+	// test; a clean nil, nil return is what lets the finalizer come off.
+	rm := &resourceManager{}
+	archived := &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{TableName: aws.String("archived")},
+		Status: v1alpha1.TableStatus{
+			TableStatus: aws.String("ARCHIVED"),
+			ArchivalSummary: &v1alpha1.ArchivalSummary{
+				ArchivalReason: aws.String("INACCESSIBLE_ENCRYPTION_CREDENTIALS"),
+			},
+		},
+	}}
+	latest, err := rm.sdkDelete(context.Background(), archived)
+	if err != nil {
+		t.Fatalf("sdkDelete() of an ARCHIVED table error = %v, want nil", err)
+	}
+	if latest != nil {
+		t.Fatalf("sdkDelete() of an ARCHIVED table latest = %v, want nil", latest)
+	}
+
+	// While the archival is still in flight the delete just waits for it.
+	archived.ko.Status.TableStatus = aws.String("ARCHIVING")
+	if _, err := rm.sdkDelete(context.Background(), archived); err != requeueWaitWhileArchiving {
+		t.Fatalf("sdkDelete() of an ARCHIVING table error = %v, want requeueWaitWhileArchiving", err)
+	}
+}
+
+func Test_newResourceDelta_unmanagedTTLAndPITR(t *testing.T) {
+	// The server reports TTL and PITR enabled; vary only what the desired
+	// spec says about them. A nil block is unmanaged and must not produce a
+	// delta (which would "remediate" the setting off); only explicit values
+	// are reconciled.
+	serverSide := func() *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName: aws.String("table"),
+				TimeToLive: &v1alpha1.TimeToLiveSpecification{
+					AttributeName: aws.String("expires_at"),
+					Enabled:       aws.Bool(true),
+				},
+				ContinuousBackups: &v1alpha1.PointInTimeRecoverySpecification{
+					PointInTimeRecoveryEnabled: aws.Bool(true),
+				},
+			},
+		}}
+	}
+
+	tests := []struct {
+		name     string
+		ttl      *v1alpha1.TimeToLiveSpecification
+		pitr     *v1alpha1.PointInTimeRecoverySpecification
+		wantTTL  bool
+		wantPITR bool
+	}{
+		{
+			name:     "nil blocks leave enabled server state alone",
+			ttl:      nil,
+			pitr:     nil,
+			wantTTL:  false,
+			wantPITR: false,
+		},
+		{
+			name: "explicit false disables",
+			ttl: &v1alpha1.TimeToLiveSpecification{
+				AttributeName: aws.String("expires_at"),
+				Enabled:       aws.Bool(false),
+			},
+			pitr: &v1alpha1.PointInTimeRecoverySpecification{
+				PointInTimeRecoveryEnabled: aws.Bool(false),
+			},
+			wantTTL:  true,
+			wantPITR: true,
+		},
+		{
+			name: "explicit true matching the server is in sync",
+			ttl: &v1alpha1.TimeToLiveSpecification{
+				AttributeName: aws.String("expires_at"),
+				Enabled:       aws.Bool(true),
+			},
+			pitr: &v1alpha1.PointInTimeRecoverySpecification{
+				PointInTimeRecoveryEnabled: aws.Bool(true),
+			},
+			wantTTL:  false,
+			wantPITR: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := &resource{ko: &v1alpha1.Table{
+				Spec: v1alpha1.TableSpec{
+					TableName:         aws.String("table"),
+					TimeToLive:        tt.ttl,
+					ContinuousBackups: tt.pitr,
+				},
+			}}
+			delta := newResourceDelta(desired, serverSide())
+			if got := delta.DifferentAt("Spec.TimeToLive"); got != tt.wantTTL {
+				t.Errorf("DifferentAt(Spec.TimeToLive) = %v, want %v", got, tt.wantTTL)
+			}
+			if got := delta.DifferentAt("Spec.ContinuousBackups"); got != tt.wantPITR {
+				t.Errorf("DifferentAt(Spec.ContinuousBackups) = %v, want %v", got, tt.wantPITR)
+			}
+		})
+	}
+}
+
+func Test_syncTable_skipsEmptyUpdate(t *testing.T) {
+	// A delta that only touches hook-managed fields translates to an
+	// UpdateTable input carrying nothing but the table name, which DynamoDB
+	// rejects. The nil sdkapi proves the call is skipped instead.
+	rm := &resourceManager{}
+	r := &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{
+			TableName: aws.String("table"),
+			Tags: []*v1alpha1.Tag{
+				{Key: aws.String("team"), Value: aws.String("data")},
+			},
+		},
+	}}
+	delta := compare.NewDelta()
+	delta.Add("Spec.Tags", r.ko.Spec.Tags, nil)
+
+	if err := rm.syncTable(context.Background(), r, delta); err != nil {
+		t.Fatalf("syncTable() with a tags-only delta error = %v, want nil", err)
+	}
+
+	// A delta the payload can translate must still reach the API; with the
+	// nil client that surfaces as a panic.
+	delta.Add("Spec.TableClass", aws.String("STANDARD_INFREQUENT_ACCESS"), aws.String("STANDARD"))
+	r.ko.Spec.TableClass = aws.String("STANDARD_INFREQUENT_ACCESS")
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("syncTable() with a translatable delta did not attempt UpdateTable")
+		}
+	}()
+	_ = rm.syncTable(context.Background(), r, delta)
+}
+
+func Test_tagManagementPolicy_modes(t *testing.T) {
+	// The server carries an extra governance tag on top of the one the spec
+	// declares, and the spec's value for "env" differs.
+	newDesired := func(policy string) *resource {
+		r := &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName: aws.String("table"),
+				Tags: []*v1alpha1.Tag{
+					{Key: aws.String("env"), Value: aws.String("prod")},
+				},
+			},
+		}}
+		if policy != "" {
+			r.ko.ObjectMeta.Annotations = map[string]string{
+				AnnotationTagManagementPolicy: policy,
+			}
+		}
+		return r
+	}
+	newLatest := func() *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName: aws.String("table"),
+				Tags: []*v1alpha1.Tag{
+					{Key: aws.String("env"), Value: aws.String("staging")},
+					{Key: aws.String("cost-center"), Value: aws.String("1234")},
+				},
+			},
+		}}
+	}
+
+	t.Run("authoritative reports the extra server tag", func(t *testing.T) {
+		delta := compare.NewDelta()
+		customPreCompare(delta, newDesired(""), newLatest())
+		if !delta.DifferentAt("Spec.Tags") {
+			t.Errorf("customPreCompare() without a policy did not report the tags delta")
+		}
+	})
+
+	t.Run("merge reports spec keys but tolerates extra server tags", func(t *testing.T) {
+		delta := compare.NewDelta()
+		customPreCompare(delta, newDesired("merge"), newLatest())
+		if !delta.DifferentAt("Spec.Tags") {
+			t.Errorf("customPreCompare() with merge did not report the changed env tag")
+		}
+
+		// With env in sync, the extra cost-center tag alone is no drift.
+		inSync := newDesired("merge")
+		inSync.ko.Spec.Tags[0].Value = aws.String("staging")
+		delta = compare.NewDelta()
+		customPreCompare(delta, inSync, newLatest())
+		if delta.DifferentAt("Spec.Tags") {
+			t.Errorf("customPreCompare() with merge reported the extra server tag as drift")
+		}
+	})
+
+	t.Run("none skips tag reconciliation entirely", func(t *testing.T) {
+		delta := compare.NewDelta()
+		customPreCompare(delta, newDesired("none"), newLatest())
+		if delta.DifferentAt("Spec.Tags") {
+			t.Errorf("customPreCompare() with none reported a tags delta")
+		}
+		// Even when called directly, the sync must not issue any tagging
+		// call; rm has no client wired up, so reaching one would panic.
+		rm := &resourceManager{}
+		if err := rm.syncTableTags(context.Background(), newDesired("none"), newLatest()); err != nil {
+			t.Errorf("syncTableTags() with none error = %v, want nil", err)
+		}
+	})
+
+	t.Run("merge never issues UntagResource", func(t *testing.T) {
+		// The only pending change is a removal, which merge drops; with the
+		// nil client an UntagResource attempt would panic.
+		rm := &resourceManager{}
+		desired := newDesired("merge")
+		desired.ko.Spec.Tags[0].Value = aws.String("staging")
+		latest := newLatest()
+		arn := ackv1alpha1.AWSResourceName("arn:aws:dynamodb:us-west-2:111122223333:table/table")
+		latest.ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{ARN: &arn}
+		if err := rm.syncTableTags(context.Background(), desired, latest); err != nil {
+			t.Errorf("syncTableTags() with merge error = %v, want nil", err)
+		}
+	})
+}
+
+func Test_customUpdateTable_throughputDecreaseLimit(t *testing.T) {
+	// The table has burned through the daily decrease budget; the pending
+	// capacity decrease must requeue without touching the API. rm has no
+	// SDK client wired up, so an UpdateTable attempt would panic.
+	rm := &resourceManager{}
+	newResource := func(rcu int64) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName: aws.String("table"),
+				ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(rcu),
+					WriteCapacityUnits: aws.Int64(10),
+				},
+			},
+		}}
+	}
+	desired := newResource(5)
+	latest := newResource(10)
+	latest.ko.Status.TableStatus = aws.String("ACTIVE")
+	latest.ko.Status.ProvisionedThroughputDescription = &v1alpha1.ProvisionedThroughputDescription{
+		NumberOfDecreasesToday: aws.Int64(maxThroughputDecreasesPerDay),
+	}
+	delta := compare.NewDelta()
+	delta.Add("Spec.ProvisionedThroughput", desired.ko.Spec.ProvisionedThroughput, latest.ko.Spec.ProvisionedThroughput)
+
+	_, err := rm.customUpdateTable(context.Background(), desired, latest, delta)
+	if !errors.Is(err, ErrThroughputDecreaseBudget) {
+		t.Fatalf("customUpdateTable() error = %v, want ErrThroughputDecreaseBudget", err)
+	}
+	var requeueErr *ackrequeue.RequeueNeededAfter
+	if !errors.As(err, &requeueErr) {
+		t.Fatalf("customUpdateTable() error = %v, want a delayed requeue", err)
+	}
+	// The requeue parks the decrease until the next UTC day, when the
+	// budget resets.
+	if d := requeueErr.Duration(); d <= 0 || d > 24*time.Hour {
+		t.Errorf("requeue duration = %v, want within the next UTC day", d)
+	}
+
+	// A capacity increase is never deferred, regardless of the counter.
+	if msg, deferred := throughputDecreaseDeferred(newResource(20), latest); deferred {
+		t.Fatalf("throughputDecreaseDeferred() deferred a capacity increase: %s", msg)
+	}
+
+	// Below the daily limit the decrease goes through to the API.
+	latest.ko.Status.ProvisionedThroughputDescription.NumberOfDecreasesToday = aws.Int64(3)
+	if msg, deferred := throughputDecreaseDeferred(desired, latest); deferred {
+		t.Fatalf("throughputDecreaseDeferred() deferred below the daily limit: %s", msg)
+	}
+}
+
+func Test_deletionProtectionDisableDeferred(t *testing.T) {
+	protectedTable := func(protection bool, gsis []*v1alpha1.GlobalSecondaryIndex, replicas []*v1alpha1.CreateReplicationGroupMemberAction) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:                 aws.String("table"),
+				DeletionProtectionEnabled: aws.Bool(protection),
+				GlobalSecondaryIndexes:    gsis,
+				TableReplicas:             replicas,
+			},
+		}}
+	}
+	gsi := func(name string) *v1alpha1.GlobalSecondaryIndex {
+		return &v1alpha1.GlobalSecondaryIndex{IndexName: aws.String(name)}
+	}
+	replica := func(region string) *v1alpha1.CreateReplicationGroupMemberAction {
+		return &v1alpha1.CreateReplicationGroupMemberAction{RegionName: aws.String(region)}
+	}
+	protectionDelta := func(destructivePaths ...string) *compare.Delta {
+		delta := compare.NewDelta()
+		delta.Add("Spec.DeletionProtectionEnabled", nil, nil)
+		for _, path := range destructivePaths {
+			delta.Add(path, nil, nil)
+		}
+		return delta
+	}
+
+	t.Run("disable with a pending GSI removal is deferred", func(t *testing.T) {
+		desired := protectedTable(false, nil, nil)
+		latest := protectedTable(true, []*v1alpha1.GlobalSecondaryIndex{gsi("index1")}, nil)
+		if !deletionProtectionDisableDeferred(protectionDelta("Spec.GlobalSecondaryIndexes"), desired, latest) {
+			t.Error("expected the disable to wait for the GSI removal")
+		}
+	})
+
+	t.Run("disable with only a GSI addition is not deferred", func(t *testing.T) {
+		desired := protectedTable(false, []*v1alpha1.GlobalSecondaryIndex{gsi("index1")}, nil)
+		latest := protectedTable(true, nil, nil)
+		if deletionProtectionDisableDeferred(protectionDelta("Spec.GlobalSecondaryIndexes"), desired, latest) {
+			t.Error("a GSI addition is not destructive; the disable must not wait")
+		}
+	})
+
+	t.Run("enable is never deferred", func(t *testing.T) {
+		desired := protectedTable(true, nil, nil)
+		latest := protectedTable(false, []*v1alpha1.GlobalSecondaryIndex{gsi("index1")}, nil)
+		if deletionProtectionDisableDeferred(protectionDelta("Spec.GlobalSecondaryIndexes"), desired, latest) {
+			t.Error("enabling protection must go out immediately")
+		}
+	})
+
+	t.Run("disable with a pending replica removal is deferred", func(t *testing.T) {
+		desired := protectedTable(false, nil, nil)
+		latest := protectedTable(true, nil, []*v1alpha1.CreateReplicationGroupMemberAction{replica("us-west-2")})
+		if !deletionProtectionDisableDeferred(protectionDelta("Spec.TableReplicas"), desired, latest) {
+			t.Error("expected the disable to wait for the replica removal")
+		}
+	})
+
+	t.Run("retained unmanaged GSIs do not defer the disable", func(t *testing.T) {
+		desired := protectedTable(false, nil, nil)
+		desired.ko.ObjectMeta.Annotations = map[string]string{
+			AnnotationUnmanagedGSIPolicy: "retain",
+		}
+		latest := protectedTable(true, []*v1alpha1.GlobalSecondaryIndex{gsi("index1")}, nil)
+		if deletionProtectionDisableDeferred(protectionDelta("Spec.GlobalSecondaryIndexes"), desired, latest) {
+			t.Error("under the retain policy no index is deleted; the disable must not wait")
+		}
+	})
+}
+
+func Test_customUpdateTable_deletionProtectionOrdering(t *testing.T) {
+	// rm has no SDK client wired up: reaching any SDK call panics the test,
+	// which is what distinguishes "protection applied now" from "protection
+	// held back".
+	rm := &resourceManager{}
+	newResource := func(protection bool, gsis ...*v1alpha1.GlobalSecondaryIndex) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:                 aws.String("table"),
+				DeletionProtectionEnabled: aws.Bool(protection),
+				GlobalSecondaryIndexes:    gsis,
+			},
+		}}
+	}
+
+	t.Run("disable is held back behind a pending GSI removal", func(t *testing.T) {
+		desired := newResource(false)
+		latest := newResource(true, testGSI("index1", 5, 5))
+		latest.ko.Status.TableStatus = aws.String("ACTIVE")
+		// The index is still settling, so the removal cannot go out yet;
+		// neither may the protection disable that is queued behind it. The
+		// reconcile must requeue without touching the API at all.
+		latest.ko.Status.GlobalSecondaryIndexesDescriptions = []*v1alpha1.GlobalSecondaryIndexDescription{
+			{IndexName: aws.String("index1"), IndexStatus: aws.String("UPDATING")},
+		}
+		delta := compare.NewDelta()
+		delta.Add("Spec.DeletionProtectionEnabled", desired.ko.Spec.DeletionProtectionEnabled, latest.ko.Spec.DeletionProtectionEnabled)
+		delta.Add("Spec.GlobalSecondaryIndexes", desired.ko.Spec.GlobalSecondaryIndexes, latest.ko.Spec.GlobalSecondaryIndexes)
+
+		if _, err := rm.customUpdateTable(context.Background(), desired, latest, delta); err != requeueWaitGSIReady {
+			t.Fatalf("customUpdateTable() error = %v, want requeueWaitGSIReady", err)
+		}
+	})
+
+	t.Run("enable goes out ahead of other changes", func(t *testing.T) {
+		desired := newResource(true)
+		latest := newResource(false, testGSI("index1", 5, 5))
+		latest.ko.Status.TableStatus = aws.String("ACTIVE")
+		latest.ko.Status.GlobalSecondaryIndexesDescriptions = []*v1alpha1.GlobalSecondaryIndexDescription{
+			{IndexName: aws.String("index1"), IndexStatus: aws.String("UPDATING")},
+		}
+		delta := compare.NewDelta()
+		delta.Add("Spec.DeletionProtectionEnabled", desired.ko.Spec.DeletionProtectionEnabled, latest.ko.Spec.DeletionProtectionEnabled)
+		delta.Add("Spec.GlobalSecondaryIndexes", desired.ko.Spec.GlobalSecondaryIndexes, latest.ko.Spec.GlobalSecondaryIndexes)
+
+		// Unlike the disable above, the enable must be attempted before the
+		// GSI work even though the index is still settling; with the nil
+		// client that surfaces as a panic at UpdateTable.
+		defer func() {
+			if recover() == nil {
+				t.Fatal("customUpdateTable() did not attempt to enable deletion protection first")
+			}
+		}()
+		_, _ = rm.customUpdateTable(context.Background(), desired, latest, delta)
+	})
+}
+
+func Test_syncedConditionDeltaMessage(t *testing.T) {
+	updatingTable := func() *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Status: v1alpha1.TableStatus{TableStatus: aws.String("UPDATING")},
+		}}
+	}
+
+	t.Run("empty delta reports waiting on AWS, not drift", func(t *testing.T) {
+		got := syncedConditionDeltaMessage(updatingTable(), compare.NewDelta())
+		want := "table is in 'UPDATING' status; the spec carries no further changes, waiting for the last operation to settle"
+		if got != want {
+			t.Errorf("syncedConditionDeltaMessage() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("paths are listed in manifest casing without values", func(t *testing.T) {
+		delta := compare.NewDelta()
+		// The delta carries the raw values, including a tag map the message
+		// must never echo back.
+		delta.Add("Spec.ProvisionedThroughput.WriteCapacityUnits", aws.Int64(10), aws.Int64(5))
+		delta.Add("Spec.SSESpecification", nil, nil)
+		delta.Add("Spec.Tags", []*v1alpha1.Tag{{Key: aws.String("secret"), Value: aws.String("s3cr3t")}}, nil)
+		got := syncedConditionDeltaMessage(updatingTable(), delta)
+		for _, want := range []string{
+			"table is in 'UPDATING' status",
+			"spec.provisionedThroughput.writeCapacityUnits",
+			"spec.sseSpecification",
+			"spec.tags",
+		} {
+			if !strings.Contains(got, want) {
+				t.Errorf("message %q does not contain %q", got, want)
+			}
+		}
+		if strings.Contains(got, "s3cr3t") {
+			t.Errorf("message %q leaks a tag value", got)
+		}
+	})
+
+	t.Run("long deltas are truncated with a count", func(t *testing.T) {
+		delta := compare.NewDelta()
+		for i := 0; i < syncedMessagePathLimit+2; i++ {
+			delta.Add(fmt.Sprintf("Spec.Field%02d", i), nil, nil)
+		}
+		got := syncedConditionDeltaMessage(updatingTable(), delta)
+		if !strings.Contains(got, "(and 2 more)") {
+			t.Errorf("message %q is missing the truncation marker", got)
+		}
+		if strings.Contains(got, "spec.field11") {
+			t.Errorf("message %q lists a path beyond the cap", got)
+		}
+	})
+}
+
+func Test_customUpdateTable_syncedMessageWhileUpdating(t *testing.T) {
+	// While the table is UPDATING no call goes out, but the synced condition
+	// must already say what the controller is waiting to apply.
+	rm := &resourceManager{}
+	desired := &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{
+			TableName:  aws.String("table"),
+			TableClass: aws.String("STANDARD_INFREQUENT_ACCESS"),
+		},
+	}}
+	latest := &resource{ko: &v1alpha1.Table{
+		Spec:   v1alpha1.TableSpec{TableName: aws.String("table")},
+		Status: v1alpha1.TableStatus{TableStatus: aws.String("UPDATING")},
+	}}
+	delta := compare.NewDelta()
+	delta.Add("Spec.TableClass", desired.ko.Spec.TableClass, nil)
+
+	if _, err := rm.customUpdateTable(context.Background(), desired, latest, delta); err != requeueWaitWhileUpdating {
+		t.Fatalf("customUpdateTable() error = %v, want requeueWaitWhileUpdating", err)
+	}
+	c := getSyncedCondition(desired)
+	if c == nil || c.Status != corev1.ConditionFalse || c.Message == nil {
+		t.Fatal("expected a False ResourceSynced condition with a message")
+	}
+	for _, want := range []string{"table is in 'UPDATING' status", "spec.tableClass"} {
+		if !strings.Contains(*c.Message, want) {
+			t.Errorf("condition message %q does not contain %q", *c.Message, want)
+		}
+	}
+}
+
+func Test_onDemandIgnoredThroughputFields(t *testing.T) {
+	newTable := func(billingMode string, tablePT bool, gsiPT bool) *resource {
+		ko := &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:   aws.String("table"),
+				BillingMode: aws.String(billingMode),
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					{IndexName: aws.String("gsi1")},
+				},
+			},
+		}
+		if tablePT {
+			ko.Spec.ProvisionedThroughput = &v1alpha1.ProvisionedThroughput{
+				ReadCapacityUnits:  aws.Int64(5),
+				WriteCapacityUnits: aws.Int64(5),
+			}
+		}
+		if gsiPT {
+			ko.Spec.GlobalSecondaryIndexes[0].ProvisionedThroughput = &v1alpha1.ProvisionedThroughput{
+				ReadCapacityUnits:  aws.Int64(5),
+				WriteCapacityUnits: aws.Int64(5),
+			}
+		}
+		return &resource{ko: ko}
+	}
+
+	t.Run("a provisioned spec has no ignored fields", func(t *testing.T) {
+		if fields := onDemandIgnoredThroughputFields(newTable(string(v1alpha1.BillingMode_PROVISIONED), true, true)); fields != nil {
+			t.Errorf("onDemandIgnoredThroughputFields() = %v, want nil", fields)
+		}
+	})
+
+	t.Run("an on-demand spec without throughput has no ignored fields", func(t *testing.T) {
+		if fields := onDemandIgnoredThroughputFields(newTable(string(v1alpha1.BillingMode_PAY_PER_REQUEST), false, false)); fields != nil {
+			t.Errorf("onDemandIgnoredThroughputFields() = %v, want nil", fields)
+		}
+	})
+
+	t.Run("table and index throughput are both named", func(t *testing.T) {
+		fields := onDemandIgnoredThroughputFields(newTable(string(v1alpha1.BillingMode_PAY_PER_REQUEST), true, true))
+		want := []string{
+			"spec.provisionedThroughput",
+			`spec.globalSecondaryIndexes["gsi1"].provisionedThroughput`,
+		}
+		if !reflect.DeepEqual(fields, want) {
+			t.Errorf("onDemandIgnoredThroughputFields() = %v, want %v", fields, want)
+		}
+	})
+}
+
+func Test_stripOnDemandIgnoredThroughput(t *testing.T) {
+	rm := &resourceManager{}
+	newTable := func(billingMode string) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:   aws.String("table"),
+				BillingMode: aws.String(billingMode),
+				ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(5),
+					WriteCapacityUnits: aws.Int64(5),
+				},
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					{
+						IndexName: aws.String("gsi1"),
+						ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+							ReadCapacityUnits:  aws.Int64(5),
+							WriteCapacityUnits: aws.Int64(5),
+						},
+						OnDemandThroughput: &v1alpha1.OnDemandThroughput{
+							MaxReadRequestUnits: aws.Int64(100),
+						},
+					},
+				},
+			},
+		}}
+	}
+
+	t.Run("on-demand creates drop the stored capacity", func(t *testing.T) {
+		desired := newTable(string(v1alpha1.BillingMode_PAY_PER_REQUEST))
+		input, err := rm.newCreateRequestPayload(context.Background(), desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		stripOnDemandIgnoredThroughput(input, desired)
+		if input.ProvisionedThroughput != nil {
+			t.Errorf("expected table ProvisionedThroughput stripped, got %+v", input.ProvisionedThroughput)
+		}
+		if input.GlobalSecondaryIndexes[0].ProvisionedThroughput != nil {
+			t.Errorf("expected GSI ProvisionedThroughput stripped, got %+v", input.GlobalSecondaryIndexes[0].ProvisionedThroughput)
+		}
+		if input.GlobalSecondaryIndexes[0].OnDemandThroughput == nil {
+			t.Error("on-demand throughput must survive the strip")
+		}
+		// The spec keeps the values for a later switch to PROVISIONED.
+		if desired.ko.Spec.ProvisionedThroughput == nil {
+			t.Error("spec.provisionedThroughput must not be cleared")
+		}
+	})
+
+	t.Run("provisioned creates are untouched", func(t *testing.T) {
+		desired := newTable(string(v1alpha1.BillingMode_PROVISIONED))
+		input, err := rm.newCreateRequestPayload(context.Background(), desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		stripOnDemandIgnoredThroughput(input, desired)
+		if input.ProvisionedThroughput == nil || input.GlobalSecondaryIndexes[0].ProvisionedThroughput == nil {
+			t.Error("provisioned throughput must be kept for a PROVISIONED create")
+		}
+	})
+}
+
+func Test_onDemandThroughputConflictDelta(t *testing.T) {
+	newTable := func(billingMode string, withPT bool) *resource {
+		ko := &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:   aws.String("table"),
+				BillingMode: aws.String(billingMode),
+			},
+		}
+		if withPT {
+			ko.Spec.ProvisionedThroughput = &v1alpha1.ProvisionedThroughput{
+				ReadCapacityUnits:  aws.Int64(5),
+				WriteCapacityUnits: aws.Int64(5),
+			}
+		}
+		return &resource{ko: ko}
+	}
+
+	t.Run("stored throughput never diffs while billing on-demand", func(t *testing.T) {
+		desired := newTable(string(v1alpha1.BillingMode_PAY_PER_REQUEST), true)
+		latest := newTable(string(v1alpha1.BillingMode_PAY_PER_REQUEST), false)
+		delta := newResourceDelta(desired, latest)
+		if delta.DifferentAt("Spec.ProvisionedThroughput") {
+			t.Error("unexpected delta at Spec.ProvisionedThroughput")
+		}
+	})
+
+	t.Run("switching to PROVISIONED makes the stored values meaningful again", func(t *testing.T) {
+		desired := newTable(string(v1alpha1.BillingMode_PROVISIONED), true)
+		latest := newTable(string(v1alpha1.BillingMode_PAY_PER_REQUEST), false)
+		delta := newResourceDelta(desired, latest)
+		if !delta.DifferentAt("Spec.BillingMode") {
+			t.Error("expected delta at Spec.BillingMode")
+		}
+		if !delta.DifferentAt("Spec.ProvisionedThroughput") {
+			t.Error("expected delta at Spec.ProvisionedThroughput")
+		}
+	})
+}
+
+func Test_disableContributorInsightsForDeletedGSIs(t *testing.T) {
+	rm := &resourceManager{}
+	newLatest := func(insights *string) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName: aws.String("table"),
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					{IndexName: aws.String("gsi1"), ContributorInsights: insights},
+				},
+			},
+		}}
+	}
+	deleteInput := func(indexName string) *svcsdk.UpdateTableInput {
+		return &svcsdk.UpdateTableInput{
+			TableName: aws.String("table"),
+			GlobalSecondaryIndexUpdates: []svcsdktypes.GlobalSecondaryIndexUpdate{
+				{Delete: &svcsdktypes.DeleteGlobalSecondaryIndexAction{
+					IndexName: aws.String(indexName),
+				}},
+			},
+		}
+	}
+
+	t.Run("deleting an index with insights enabled disables them", func(t *testing.T) {
+		latest := newLatest(aws.String(string(svcsdktypes.ContributorInsightsStatusEnabled)))
+		// The nil SDK client panics when UpdateContributorInsights is
+		// reached, proving the disable call is issued for the deleted index.
+		defer func() {
+			if recover() == nil {
+				t.Errorf("expected the disable call to reach UpdateContributorInsights")
+			}
+		}()
+		rm.disableContributorInsightsForDeletedGSIs(context.Background(), latest, latest, deleteInput("gsi1"))
+	})
+
+	t.Run("deleting an index without insights issues no call", func(t *testing.T) {
+		latest := newLatest(nil)
+		rm.disableContributorInsightsForDeletedGSIs(context.Background(), latest, latest, deleteInput("gsi1"))
+	})
+
+	t.Run("non-delete actions issue no call", func(t *testing.T) {
+		latest := newLatest(aws.String(string(svcsdktypes.ContributorInsightsStatusEnabled)))
+		input := &svcsdk.UpdateTableInput{
+			TableName: aws.String("table"),
+			GlobalSecondaryIndexUpdates: []svcsdktypes.GlobalSecondaryIndexUpdate{
+				{Update: &svcsdktypes.UpdateGlobalSecondaryIndexAction{
+					IndexName: aws.String("gsi1"),
+				}},
+			},
+		}
+		rm.disableContributorInsightsForDeletedGSIs(context.Background(), latest, latest, input)
+	})
+}
+
+func Test_gsiContributorInsightsEnabled(t *testing.T) {
+	newLatest := func(insights *string) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					{IndexName: aws.String("gsi1"), ContributorInsights: insights},
+				},
+			},
+		}}
+	}
+	tests := []struct {
+		name     string
+		insights *string
+		want     bool
+	}{
+		{"enabled", aws.String("ENABLED"), true},
+		{"still enabling", aws.String("ENABLING"), true},
+		{"disabled", aws.String("DISABLED"), false},
+		{"unset", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gsiContributorInsightsEnabled(newLatest(tt.insights), "gsi1"); got != tt.want {
+				t.Errorf("gsiContributorInsightsEnabled() = %v, want %v", got, tt.want)
+			}
+			if gsiContributorInsightsEnabled(newLatest(tt.insights), "absent") {
+				t.Error("an index missing from the observed spec must report false")
+			}
+		})
+	}
+}
+
+func Test_gsiContributorInsightsDriftSkipsMissingIndexes(t *testing.T) {
+	newTable := func(gsis ...*v1alpha1.GlobalSecondaryIndex) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:              aws.String("table"),
+				GlobalSecondaryIndexes: gsis,
+			},
+		}}
+	}
+	managedGSI := func(insights string) *v1alpha1.GlobalSecondaryIndex {
+		return &v1alpha1.GlobalSecondaryIndex{
+			IndexName:           aws.String("gsi1"),
+			ContributorInsights: aws.String(insights),
+		}
+	}
+
+	t.Run("a spec index not on the table yet produces no insights delta", func(t *testing.T) {
+		desired := newTable(managedGSI("ENABLE"))
+		latest := newTable()
+		if delta := newResourceDelta(desired, latest); delta.DifferentAt("Spec.ContributorInsights") {
+			t.Error("unexpected delta at Spec.ContributorInsights for a missing index")
+		}
+	})
+
+	t.Run("an existing index still drifts", func(t *testing.T) {
+		desired := newTable(managedGSI("ENABLE"))
+		latest := newTable(managedGSI("DISABLED"))
+		if delta := newResourceDelta(desired, latest); !delta.DifferentAt("Spec.ContributorInsights") {
+			t.Error("expected delta at Spec.ContributorInsights for an existing index")
+		}
+	})
+}
+
+func Test_updateContributorInsights_gsiOnlyDriftSkipsTableLevel(t *testing.T) {
+	rm := &resourceManager{}
+	newResource := func(tableInsights *string, gsiStatus string) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			Spec: v1alpha1.TableSpec{
+				TableName:           aws.String("table"),
+				ContributorInsights: tableInsights,
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					{IndexName: aws.String("gsi1"), ContributorInsights: aws.String("ENABLE")},
+				},
+			},
+			Status: v1alpha1.TableStatus{
+				GlobalSecondaryIndexesDescriptions: []*v1alpha1.GlobalSecondaryIndexDescription{
+					{IndexName: aws.String("gsi1"), IndexStatus: aws.String(gsiStatus)},
+				},
+			},
+		}}
+	}
+
+	t.Run("GSI-only drift leaves the table-level setting alone", func(t *testing.T) {
+		// With the index not yet ACTIVE the GSI loop requeues before its own
+		// SDK call; the table-level call comes first and would panic on the
+		// nil client, so a clean requeue proves it was skipped.
+		r := newResource(nil, string(svcsdktypes.IndexStatusCreating))
+		if err := rm.updateContributorInsights(context.Background(), r, r); err != requeueWaitGSIReady {
+			t.Fatalf("updateContributorInsights() error = %v, want requeueWaitGSIReady", err)
+		}
+	})
+
+	t.Run("a managed table-level setting is still synced", func(t *testing.T) {
+		r := newResource(aws.String("ENABLE"), string(svcsdktypes.IndexStatusCreating))
+		defer func() {
+			if recover() == nil {
+				t.Errorf("expected the table-level call to reach UpdateContributorInsights")
+			}
+		}()
+		rm.updateContributorInsights(context.Background(), r, r)
+	})
+}
@@ -15,7 +15,13 @@ package table
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
@@ -24,6 +30,17 @@ import (
 	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
 )
 
+var (
+	requeueWaitWhileTTLDisabling = ackrequeue.NeededAfter(
+		errors.New("TTL is being disabled before it can be enabled with the new attribute, waiting"),
+		10*time.Second,
+	)
+	requeueWaitTTLCooldown = ackrequeue.NeededAfter(
+		errors.New("TTL was modified multiple times within a fixed interval, waiting for the cooldown to pass"),
+		time.Minute,
+	)
+)
+
 // syncTTL updates a dynamodb table's TimeToLive property.
 func (rm *resourceManager) syncTTL(
 	ctx context.Context,
@@ -34,6 +51,16 @@ func (rm *resourceManager) syncTTL(
 	exit := rlog.Trace("rm.syncTTL")
 	defer func(err error) { exit(err) }(err)
 
+	// DynamoDB cannot switch the TTL attribute in a single call: the old
+	// attribute has to be disabled first and the new one enabled only once
+	// the table reports the TTL status as DISABLED.
+	if isTTLAttributeRenaming(desired, latest) {
+		return rm.disableTTL(ctx, desired, latest)
+	}
+	if isTTLDisabling(latest) {
+		return requeueWaitWhileTTLDisabling
+	}
+
 	ttlSpec := &svcsdktypes.TimeToLiveSpecification{}
 	if desired.ko.Spec.TimeToLive != nil {
 		ttlSpec.AttributeName = desired.ko.Spec.TimeToLive.AttributeName
@@ -61,11 +88,93 @@ func (rm *resourceManager) syncTTL(
 		},
 	)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateTimeToLive", err)
+	recordSDKCall("UpdateTimeToLive", err)
+	if err != nil {
+		return ttlAPIError(err)
+	}
+	oldTTL := "disabled"
+	if latest.ko.Spec.TimeToLive != nil {
+		oldTTL = enabledOrDisabled(latest.ko.Spec.TimeToLive.Enabled)
+	}
+	recordTableEvent(desired, "UpdatedTimeToLive", fmt.Sprintf(
+		"TTL: %s→%s (attribute %q)",
+		oldTTL, enabledOrDisabled(ttlSpec.Enabled), aws.ToString(ttlSpec.AttributeName),
+	))
+	return nil
+}
+
+// isTTLAttributeRenaming returns true if TTL is enabled on both sides but
+// with different attribute names, which requires a disable/enable sequence.
+func isTTLAttributeRenaming(desired *resource, latest *resource) bool {
+	desiredTTL := desired.ko.Spec.TimeToLive
+	latestTTL := latest.ko.Spec.TimeToLive
+	if desiredTTL == nil || latestTTL == nil ||
+		desiredTTL.AttributeName == nil || latestTTL.AttributeName == nil {
+		return false
+	}
+	if desiredTTL.Enabled == nil || !*desiredTTL.Enabled ||
+		latestTTL.Enabled == nil || !*latestTTL.Enabled {
+		return false
+	}
+	return *desiredTTL.AttributeName != *latestTTL.AttributeName
+}
+
+// isTTLDisabling returns true if the table reports the TTL status as
+// DISABLING, during which any further TTL change is rejected.
+func isTTLDisabling(latest *resource) bool {
+	ttlDescription := latest.ko.Status.TimeToLiveDescription
+	return ttlDescription != nil && ttlDescription.TimeToLiveStatus != nil &&
+		*ttlDescription.TimeToLiveStatus == string(svcsdktypes.TimeToLiveStatusDisabling)
+}
+
+// disableTTL turns off TTL on the currently enabled attribute and requeues.
+// The next reconciles wait until the status reaches DISABLED, at which point
+// the regular sync enables TTL with the new attribute name.
+func (rm *resourceManager) disableTTL(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.disableTTL")
+	defer func(err error) { exit(err) }(err)
+
+	_, err = rm.sdkapi.UpdateTimeToLive(
+		ctx,
+		&svcsdk.UpdateTimeToLiveInput{
+			TableName: desired.ko.Spec.TableName,
+			TimeToLiveSpecification: &svcsdktypes.TimeToLiveSpecification{
+				AttributeName: latest.ko.Spec.TimeToLive.AttributeName,
+				Enabled:       aws.Bool(false),
+			},
+		},
+	)
+	rm.metrics.RecordAPICall("UPDATE", "UpdateTimeToLive", err)
+	recordSDKCall("UpdateTimeToLive", err)
+	if err != nil {
+		return ttlAPIError(err)
+	}
+	return requeueWaitWhileTTLDisabling
+}
+
+// ttlAPIError maps the UpdateTimeToLive cooldown rejection to a requeue with
+// a longer delay: DynamoDB refuses TTL changes for a while after a previous
+// one, and the change simply has to be retried later.
+func ttlAPIError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if awsErr, ok := ackerr.AWSError(err); ok &&
+		awsErr.ErrorCode() == "ValidationException" &&
+		strings.Contains(awsErr.ErrorMessage(), "Time to live has been modified multiple times within a fixed interval") {
+		return requeueWaitTTLCooldown
+	}
 	return err
 }
 
-// getResourceTTLWithContext queries the table TTL of a given resource.
-func (rm *resourceManager) getResourceTTLWithContext(ctx context.Context, tableName *string) (*v1alpha1.TimeToLiveSpecification, error) {
+// getResourceTTLWithContext queries the table TTL of a given resource along
+// with the description of its current status.
+func (rm *resourceManager) getResourceTTLWithContext(ctx context.Context, tableName *string) (*v1alpha1.TimeToLiveSpecification, *v1alpha1.TimeToLiveDescription, error) {
 	var err error
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.getResourceTTLWithContext")
@@ -78,16 +187,24 @@ func (rm *resourceManager) getResourceTTLWithContext(ctx context.Context, tableN
 		},
 	)
 	rm.metrics.RecordAPICall("GET", "DescribeTimeToLive", err)
+	recordSDKCall("DescribeTimeToLive", err)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Treat status "ENABLING" and "ENABLED" as `Enabled` == true
 	isEnabled := res.TimeToLiveDescription.TimeToLiveStatus == svcsdktypes.TimeToLiveStatusEnabled ||
 		res.TimeToLiveDescription.TimeToLiveStatus == svcsdktypes.TimeToLiveStatusEnabling
 
-	return &v1alpha1.TimeToLiveSpecification{
+	ttlSpec := &v1alpha1.TimeToLiveSpecification{
 		AttributeName: res.TimeToLiveDescription.AttributeName,
 		Enabled:       &isEnabled,
-	}, nil
+	}
+	ttlDescription := &v1alpha1.TimeToLiveDescription{
+		AttributeName: res.TimeToLiveDescription.AttributeName,
+		TimeToLiveStatus: aws.String(
+			string(res.TimeToLiveDescription.TimeToLiveStatus),
+		),
+	}
+	return ttlSpec, ttlDescription, nil
 }
@@ -0,0 +1,122 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	smithy "github.com/aws/smithy-go"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func tableWithTTL(attributeName string, enabled bool) *resource {
+	return &resource{ko: &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{
+			TableName: aws.String("table"),
+			TimeToLive: &v1alpha1.TimeToLiveSpecification{
+				AttributeName: aws.String(attributeName),
+				Enabled:       aws.Bool(enabled),
+			},
+		},
+	}}
+}
+
+func Test_isTTLAttributeRenaming(t *testing.T) {
+	tests := []struct {
+		name    string
+		desired *resource
+		latest  *resource
+		want    bool
+	}{
+		{
+			name:    "attribute renamed while enabled",
+			desired: tableWithTTL("ttl", true),
+			latest:  tableWithTTL("expireAt", true),
+			want:    true,
+		},
+		{
+			name:    "same attribute",
+			desired: tableWithTTL("ttl", true),
+			latest:  tableWithTTL("ttl", true),
+			want:    false,
+		},
+		{
+			name:    "latest TTL disabled",
+			desired: tableWithTTL("ttl", true),
+			latest:  tableWithTTL("expireAt", false),
+			want:    false,
+		},
+		{
+			name:    "desired TTL disabled",
+			desired: tableWithTTL("ttl", false),
+			latest:  tableWithTTL("expireAt", true),
+			want:    false,
+		},
+		{
+			name:    "no TTL on latest",
+			desired: tableWithTTL("ttl", true),
+			latest:  &resource{ko: &v1alpha1.Table{}},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTTLAttributeRenaming(tt.desired, tt.latest); got != tt.want {
+				t.Errorf("isTTLAttributeRenaming() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isTTLDisabling(t *testing.T) {
+	latest := tableWithTTL("expireAt", false)
+	if isTTLDisabling(latest) {
+		t.Errorf("isTTLDisabling() = true without a TTL description, want false")
+	}
+	latest.ko.Status.TimeToLiveDescription = &v1alpha1.TimeToLiveDescription{
+		AttributeName:    aws.String("expireAt"),
+		TimeToLiveStatus: aws.String("DISABLING"),
+	}
+	if !isTTLDisabling(latest) {
+		t.Errorf("isTTLDisabling() = false with status DISABLING, want true")
+	}
+	latest.ko.Status.TimeToLiveDescription.TimeToLiveStatus = aws.String("DISABLED")
+	if isTTLDisabling(latest) {
+		t.Errorf("isTTLDisabling() = true with status DISABLED, want false")
+	}
+}
+
+func Test_ttlAPIError(t *testing.T) {
+	cooldownErr := &smithy.GenericAPIError{
+		Code:    "ValidationException",
+		Message: "Time to live has been modified multiple times within a fixed interval",
+	}
+	if got := ttlAPIError(cooldownErr); got != requeueWaitTTLCooldown {
+		t.Errorf("ttlAPIError() = %v, want requeueWaitTTLCooldown", got)
+	}
+	otherErr := &smithy.GenericAPIError{Code: "ValidationException", Message: "TimeToLive is already disabled"}
+	if got := ttlAPIError(otherErr); got != otherErr {
+		t.Errorf("ttlAPIError() = %v, want the original error", got)
+	}
+	plainErr := errors.New("boom")
+	if got := ttlAPIError(plainErr); got != plainErr {
+		t.Errorf("ttlAPIError() = %v, want the original error", got)
+	}
+	if got := ttlAPIError(nil); got != nil {
+		t.Errorf("ttlAPIError() = %v, want nil", got)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"context"
+	"fmt"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+// syncTableWarmThroughput updates a given table warm throughput. Warm
+// throughput can only be increased, so a spec value below what the table
+// currently reports is rejected as terminal instead of looping on a failed
+// UpdateTable call.
+func (rm *resourceManager) syncTableWarmThroughput(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTableWarmThroughput")
+	defer exit(err)
+
+	if err := validateWarmThroughputIncrease(
+		desired.ko.Spec.WarmThroughput,
+		tableWarmThroughputFromDescription(latest.ko.Status.WarmThroughputDescription),
+	); err != nil {
+		return err
+	}
+
+	input := &svcsdk.UpdateTableInput{
+		TableName:      aws.String(*desired.ko.Spec.TableName),
+		WarmThroughput: newSDKWarmThroughput(desired.ko.Spec.WarmThroughput),
+	}
+
+	_, err = rm.sdkapi.UpdateTable(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "UpdateTable", err)
+	recordSDKCall("UpdateTable", err)
+	if err != nil {
+		return err
+	}
+	return err
+}
+
+// validateWarmThroughputIncrease returns a terminal error if the desired warm
+// throughput is lower than the value the table or index currently reports.
+func validateWarmThroughputIncrease(desired, current *v1alpha1.WarmThroughput) error {
+	if desired == nil || current == nil {
+		return nil
+	}
+	if desired.ReadUnitsPerSecond != nil && current.ReadUnitsPerSecond != nil &&
+		*desired.ReadUnitsPerSecond < *current.ReadUnitsPerSecond {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"warm throughput read units per second can only be increased: requested %d, current %d",
+			*desired.ReadUnitsPerSecond, *current.ReadUnitsPerSecond,
+		))
+	}
+	if desired.WriteUnitsPerSecond != nil && current.WriteUnitsPerSecond != nil &&
+		*desired.WriteUnitsPerSecond < *current.WriteUnitsPerSecond {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"warm throughput write units per second can only be increased: requested %d, current %d",
+			*desired.WriteUnitsPerSecond, *current.WriteUnitsPerSecond,
+		))
+	}
+	return nil
+}
+
+// newSDKWarmThroughput builds a new *svcsdktypes.WarmThroughput
+func newSDKWarmThroughput(wt *v1alpha1.WarmThroughput) *svcsdktypes.WarmThroughput {
+	if wt == nil {
+		return nil
+	}
+	warmThroughput := &svcsdktypes.WarmThroughput{}
+	if wt.ReadUnitsPerSecond != nil {
+		warmThroughput.ReadUnitsPerSecond = aws.Int64(*wt.ReadUnitsPerSecond)
+	}
+	if wt.WriteUnitsPerSecond != nil {
+		warmThroughput.WriteUnitsPerSecond = aws.Int64(*wt.WriteUnitsPerSecond)
+	}
+	return warmThroughput
+}
+
+// tableWarmThroughputFromDescription converts the warm throughput description
+// DynamoDB reports for the base table into the spec shape used for comparison.
+func tableWarmThroughputFromDescription(d *v1alpha1.TableWarmThroughputDescription) *v1alpha1.WarmThroughput {
+	if d == nil {
+		return nil
+	}
+	return &v1alpha1.WarmThroughput{
+		ReadUnitsPerSecond:  d.ReadUnitsPerSecond,
+		WriteUnitsPerSecond: d.WriteUnitsPerSecond,
+	}
+}
+
+// equalWarmThroughputs returns whether two WarmThroughput objects are equal or
+// not. DynamoDB always reports a warm throughput value, so a nil object or a
+// nil units value means the field is unmanaged and never counts as drift.
+func equalWarmThroughputs(
+	a *v1alpha1.WarmThroughput,
+	b *v1alpha1.WarmThroughput,
+) bool {
+	if a == nil || b == nil {
+		return true
+	}
+	if a.ReadUnitsPerSecond != nil && b.ReadUnitsPerSecond != nil &&
+		*a.ReadUnitsPerSecond != *b.ReadUnitsPerSecond {
+		return false
+	}
+	if a.WriteUnitsPerSecond != nil && b.WriteUnitsPerSecond != nil &&
+		*a.WriteUnitsPerSecond != *b.WriteUnitsPerSecond {
+		return false
+	}
+	return true
+}
+
+// setGlobalSecondaryIndexWarmThroughputs copies the warm throughput reported
+// for each global secondary index into the matching Spec entry so that the
+// delta comparison sees the server-side values.
+func setGlobalSecondaryIndexWarmThroughputs(
+	ko *v1alpha1.Table,
+	gsis []svcsdktypes.GlobalSecondaryIndexDescription,
+) {
+	for _, gsi := range gsis {
+		if gsi.IndexName == nil || gsi.WarmThroughput == nil {
+			continue
+		}
+		for _, specGSI := range ko.Spec.GlobalSecondaryIndexes {
+			if specGSI.IndexName != nil && *specGSI.IndexName == *gsi.IndexName {
+				specGSI.WarmThroughput = &v1alpha1.WarmThroughput{
+					ReadUnitsPerSecond:  gsi.WarmThroughput.ReadUnitsPerSecond,
+					WriteUnitsPerSecond: gsi.WarmThroughput.WriteUnitsPerSecond,
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package table
+
+import (
+	"reflect"
+	"testing"
+
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func Test_newSDKWarmThroughput(t *testing.T) {
+	type args struct {
+		wt *v1alpha1.WarmThroughput
+	}
+	tests := []struct {
+		name string
+		args args
+		want *svcsdktypes.WarmThroughput
+	}{
+		{
+			name: "warm throughput is nil",
+			args: args{
+				wt: nil,
+			},
+			want: nil,
+		},
+		{
+			name: "warm throughput with only write units per second",
+			args: args{
+				wt: &v1alpha1.WarmThroughput{
+					WriteUnitsPerSecond: aws.Int64(500),
+				},
+			},
+			want: &svcsdktypes.WarmThroughput{
+				WriteUnitsPerSecond: aws.Int64(500),
+			},
+		},
+		{
+			name: "warm throughput with only read units per second",
+			args: args{
+				wt: &v1alpha1.WarmThroughput{
+					ReadUnitsPerSecond: aws.Int64(1000),
+				},
+			},
+			want: &svcsdktypes.WarmThroughput{
+				ReadUnitsPerSecond: aws.Int64(1000),
+			},
+		},
+		{
+			name: "warm throughput with both units per second",
+			args: args{
+				wt: &v1alpha1.WarmThroughput{
+					ReadUnitsPerSecond:  aws.Int64(1000),
+					WriteUnitsPerSecond: aws.Int64(500),
+				},
+			},
+			want: &svcsdktypes.WarmThroughput{
+				ReadUnitsPerSecond:  aws.Int64(1000),
+				WriteUnitsPerSecond: aws.Int64(500),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newSDKWarmThroughput(tt.args.wt); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newSDKWarmThroughput() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_equalWarmThroughputs(t *testing.T) {
+	type args struct {
+		a *v1alpha1.WarmThroughput
+		b *v1alpha1.WarmThroughput
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "unmanaged warm throughput never drifts",
+			args: args{
+				a: nil,
+				b: &v1alpha1.WarmThroughput{
+					ReadUnitsPerSecond:  aws.Int64(12000),
+					WriteUnitsPerSecond: aws.Int64(4000),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "same units",
+			args: args{
+				a: &v1alpha1.WarmThroughput{
+					ReadUnitsPerSecond:  aws.Int64(20000),
+					WriteUnitsPerSecond: aws.Int64(8000),
+				},
+				b: &v1alpha1.WarmThroughput{
+					ReadUnitsPerSecond:  aws.Int64(20000),
+					WriteUnitsPerSecond: aws.Int64(8000),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "different read units",
+			args: args{
+				a: &v1alpha1.WarmThroughput{
+					ReadUnitsPerSecond: aws.Int64(20000),
+				},
+				b: &v1alpha1.WarmThroughput{
+					ReadUnitsPerSecond:  aws.Int64(12000),
+					WriteUnitsPerSecond: aws.Int64(4000),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "unset units are skipped",
+			args: args{
+				a: &v1alpha1.WarmThroughput{
+					WriteUnitsPerSecond: aws.Int64(4000),
+				},
+				b: &v1alpha1.WarmThroughput{
+					ReadUnitsPerSecond:  aws.Int64(12000),
+					WriteUnitsPerSecond: aws.Int64(4000),
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := equalWarmThroughputs(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("equalWarmThroughputs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_validateWarmThroughputIncrease(t *testing.T) {
+	type args struct {
+		desired *v1alpha1.WarmThroughput
+		current *v1alpha1.WarmThroughput
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "increase is allowed",
+			args: args{
+				desired: &v1alpha1.WarmThroughput{
+					ReadUnitsPerSecond:  aws.Int64(20000),
+					WriteUnitsPerSecond: aws.Int64(8000),
+				},
+				current: &v1alpha1.WarmThroughput{
+					ReadUnitsPerSecond:  aws.Int64(12000),
+					WriteUnitsPerSecond: aws.Int64(4000),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "read units decrease is terminal",
+			args: args{
+				desired: &v1alpha1.WarmThroughput{
+					ReadUnitsPerSecond: aws.Int64(1000),
+				},
+				current: &v1alpha1.WarmThroughput{
+					ReadUnitsPerSecond: aws.Int64(12000),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "write units decrease is terminal",
+			args: args{
+				desired: &v1alpha1.WarmThroughput{
+					WriteUnitsPerSecond: aws.Int64(1000),
+				},
+				current: &v1alpha1.WarmThroughput{
+					WriteUnitsPerSecond: aws.Int64(4000),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no current value",
+			args: args{
+				desired: &v1alpha1.WarmThroughput{
+					ReadUnitsPerSecond: aws.Int64(1000),
+				},
+				current: nil,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateWarmThroughputIncrease(tt.args.desired, tt.args.current); (err != nil) != tt.wantErr {
+				t.Errorf("validateWarmThroughputIncrease() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
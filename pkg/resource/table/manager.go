@@ -38,6 +38,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/dynamodb-controller/pkg/sdkclient"
 )
 
 var (
@@ -50,7 +51,7 @@ var (
 // +kubebuilder:rbac:groups=dynamodb.services.k8s.aws,resources=tables,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=dynamodb.services.k8s.aws,resources=tables/status,verbs=get;update;patch
 
-var lateInitializeFieldNames = []string{}
+var lateInitializeFieldNames = []string{"BillingMode", "DeletionProtectionEnabled", "SSESpecification", "StreamSpecification.StreamEnabled", "TableClass"}
 
 // resourceManager is responsible for providing a consistent way to perform
 // CRUD operations in a backend AWS service API for Book custom resources.
@@ -75,6 +76,10 @@ type resourceManager struct {
 	awsAccountID ackv1alpha1.AWSAccountID
 	// The AWS Region that this resource manager targets
 	awsRegion ackv1alpha1.AWSRegion
+	// awsRoleARN is the IAM role ARN assumed for the account/region this
+	// resource manager targets; empty when the controller's own credentials
+	// are used
+	awsRoleARN ackv1alpha1.AWSResourceName
 	// sdk is a pointer to the AWS service API client exposed by the
 	// aws-sdk-go-v2/services/{alias} package.
 	sdkapi *svcsdk.Client
@@ -257,7 +262,28 @@ func (rm *resourceManager) lateInitializeFromReadOneOutput(
 	observed acktypes.AWSResource,
 	latest acktypes.AWSResource,
 ) acktypes.AWSResource {
-	return latest
+	observedKo := rm.concreteResource(observed).ko
+	latestKo := rm.concreteResource(latest).ko
+	if observedKo.Spec.BillingMode != nil && latestKo.Spec.BillingMode == nil {
+		latestKo.Spec.BillingMode = observedKo.Spec.BillingMode
+	}
+	if observedKo.Spec.DeletionProtectionEnabled != nil && latestKo.Spec.DeletionProtectionEnabled == nil {
+		latestKo.Spec.DeletionProtectionEnabled = observedKo.Spec.DeletionProtectionEnabled
+	}
+	if observedKo.Spec.SSESpecification != nil && latestKo.Spec.SSESpecification == nil {
+		latestKo.Spec.SSESpecification = observedKo.Spec.SSESpecification.DeepCopy()
+	}
+	if observedKo.Spec.StreamSpecification != nil && latestKo.Spec.StreamSpecification == nil {
+		latestKo.Spec.StreamSpecification = observedKo.Spec.StreamSpecification.DeepCopy()
+	} else if observedKo.Spec.StreamSpecification != nil && latestKo.Spec.StreamSpecification != nil {
+		if observedKo.Spec.StreamSpecification.StreamEnabled != nil && latestKo.Spec.StreamSpecification.StreamEnabled == nil {
+			latestKo.Spec.StreamSpecification.StreamEnabled = observedKo.Spec.StreamSpecification.StreamEnabled
+		}
+	}
+	if observedKo.Spec.TableClass != nil && latestKo.Spec.TableClass == nil {
+		latestKo.Spec.TableClass = observedKo.Spec.TableClass
+	}
+	return &resource{latestKo}
 }
 
 // IsSynced returns true if the resource is synced.
@@ -359,6 +385,7 @@ func newResourceManager(
 	rr acktypes.Reconciler,
 	id ackv1alpha1.AWSAccountID,
 	region ackv1alpha1.AWSRegion,
+	roleARN ackv1alpha1.AWSResourceName,
 ) (*resourceManager, error) {
 	return &resourceManager{
 		cfg:          cfg,
@@ -368,7 +395,8 @@ func newResourceManager(
 		rr:           rr,
 		awsAccountID: id,
 		awsRegion:    region,
-		sdkapi:       svcsdk.NewFromConfig(clientcfg),
+		awsRoleARN:   roleARN,
+		sdkapi:       sdkclient.NewFromConfig(clientcfg),
 	}, nil
 }
 
@@ -70,7 +70,7 @@ func (f *resourceManagerFactory) ManagerFor(
 	f.Lock()
 	defer f.Unlock()
 
-	rm, err := newResourceManager(cfg, clientcfg, log, metrics, rr, id, region)
+	rm, err := newResourceManager(cfg, clientcfg, log, metrics, rr, id, region, roleARN)
 	if err != nil {
 		return nil, err
 	}
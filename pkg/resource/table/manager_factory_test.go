@@ -0,0 +1,48 @@
+package table
+
+import (
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-logr/logr"
+)
+
+// Cross-account resource management hands each reconcile the session for the
+// owner account's role mapping; the factory must hand back a distinct
+// resource manager (and thus a distinct SDK client) per account/region/role
+// combination, and the restore hooks go through that manager's client rather
+// than any table-level cache.
+func Test_ManagerFor_perRoleSessions(t *testing.T) {
+	f := newResourceManagerFactory()
+	managerFor := func(account, region, roleARN string) *resourceManager {
+		rm, err := f.ManagerFor(
+			ackcfg.Config{}, aws.Config{}, logr.Discard(), nil, nil,
+			ackv1alpha1.AWSAccountID(account),
+			ackv1alpha1.AWSRegion(region),
+			ackv1alpha1.AWSResourceName(roleARN),
+		)
+		if err != nil {
+			t.Fatalf("ManagerFor() error = %v", err)
+		}
+		return rm.(*resourceManager)
+	}
+
+	prod := managerFor("111111111111", "us-west-2", "arn:aws:iam::111111111111:role/prod")
+	staging := managerFor("222222222222", "us-west-2", "arn:aws:iam::222222222222:role/staging")
+	if prod == staging {
+		t.Fatalf("expected distinct resource managers for different accounts/roles")
+	}
+	if prod.sdkapi == staging.sdkapi {
+		t.Errorf("expected distinct SDK clients for different accounts/roles")
+	}
+	if prod.awsRoleARN != "arn:aws:iam::111111111111:role/prod" {
+		t.Errorf("awsRoleARN = %q, want the role passed to ManagerFor", prod.awsRoleARN)
+	}
+
+	// The same combination is served from the cache.
+	if again := managerFor("222222222222", "us-west-2", "arn:aws:iam::222222222222:role/staging"); again != staging {
+		t.Errorf("expected the cached resource manager for a repeated account/role")
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"context"
+	"testing"
+
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func tagsAsMap(tags []*v1alpha1.Tag) map[string]string {
+	result := map[string]string{}
+	for _, tag := range tags {
+		result[*tag.Key] = *tag.Value
+	}
+	return result
+}
+
+func Test_EnsureTags(t *testing.T) {
+	md := acktypes.ServiceControllerMetadata{
+		ServiceAlias: "dynamodb",
+	}
+	newResource := func(tags ...*v1alpha1.Tag) *resource {
+		return &resource{ko: &v1alpha1.Table{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-table",
+				Namespace: "my-namespace",
+			},
+			Spec: v1alpha1.TableSpec{
+				TableName: aws.String("my-table"),
+				Tags:      tags,
+			},
+		}}
+	}
+
+	t.Run("default tags are merged into the spec with expansions", func(t *testing.T) {
+		rm := &resourceManager{cfg: ackcfg.Config{ResourceTags: []string{
+			"team=payments",
+			"cost-center=1234",
+			"managed-by=%CONTROLLER_SERVICE%-controller/%K8S_NAMESPACE%/%K8S_RESOURCE_NAME%",
+		}}}
+		r := newResource(Tag1)
+		if err := rm.EnsureTags(context.Background(), r, md); err != nil {
+			t.Fatalf("EnsureTags() error = %v", err)
+		}
+		got := tagsAsMap(r.ko.Spec.Tags)
+		want := map[string]string{
+			"k1":          "v1",
+			"team":        "payments",
+			"cost-center": "1234",
+			"managed-by":  "dynamodb-controller/my-namespace/my-table",
+		}
+		for k, v := range want {
+			if got[k] != v {
+				t.Errorf("EnsureTags() tag %q = %q, want %q", k, got[k], v)
+			}
+		}
+	})
+
+	t.Run("spec values win on key conflicts", func(t *testing.T) {
+		rm := &resourceManager{cfg: ackcfg.Config{ResourceTags: []string{
+			"env=controller-default",
+		}}}
+		r := newResource(&v1alpha1.Tag{Key: aws.String("env"), Value: aws.String("prod")})
+		if err := rm.EnsureTags(context.Background(), r, md); err != nil {
+			t.Fatalf("EnsureTags() error = %v", err)
+		}
+		got := tagsAsMap(r.ko.Spec.Tags)
+		if got["env"] != "prod" {
+			t.Errorf("EnsureTags() tag %q = %q, want the spec value %q", "env", got["env"], "prod")
+		}
+	})
+
+	t.Run("a default removed from the config is removed on the next sync", func(t *testing.T) {
+		// The previous reconcile stamped the "team" default onto the table;
+		// once the default is dropped from the controller config the desired
+		// set no longer carries it and the tag delta removes it.
+		rm := &resourceManager{cfg: ackcfg.Config{ResourceTags: []string{
+			"cost-center=1234",
+		}}}
+		desired := newResource(Tag1)
+		if err := rm.EnsureTags(context.Background(), desired, md); err != nil {
+			t.Fatalf("EnsureTags() error = %v", err)
+		}
+		latest := newResource(
+			Tag1,
+			&v1alpha1.Tag{Key: aws.String("cost-center"), Value: aws.String("1234")},
+			&v1alpha1.Tag{Key: aws.String("team"), Value: aws.String("payments")},
+		)
+		added, removed := computeTagsDelta(desired.ko.Spec.Tags, latest.ko.Spec.Tags)
+		if len(added) != 0 {
+			t.Errorf("computeTagsDelta() added = %v, want none", added)
+		}
+		if len(removed) != 1 || removed[0] != "team" {
+			t.Errorf("computeTagsDelta() removed = %v, want [team]", removed)
+		}
+	})
+}
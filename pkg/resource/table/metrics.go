@@ -0,0 +1,184 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"sync"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+var (
+	// sdkCallsTotal counts DynamoDB control-plane calls made from the table
+	// hooks, labeled by API operation and result code so rate limiting and
+	// validation failures show up per operation.
+	sdkCallsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ack",
+			Subsystem: "dynamodb",
+			Name:      "table_sdk_calls_total",
+			Help: "Number of DynamoDB control-plane calls made while reconciling " +
+				"Table resources, labeled by operation and result code.",
+		},
+		[]string{"operation", "result"},
+	)
+	// timeToActiveSeconds observes how long a table spent in CREATING or
+	// UPDATING before it was next seen ACTIVE.
+	timeToActiveSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "ack",
+			Subsystem: "dynamodb",
+			Name:      "table_time_to_active_seconds",
+			Help: "Seconds a table spent in CREATING or UPDATING before " +
+				"reaching ACTIVE.",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 13),
+		},
+		[]string{"namespace", "name"},
+	)
+	// tablesWaitingGSIBackfill reports how many tables are currently waiting
+	// for a global secondary index to finish creating or backfilling.
+	tablesWaitingGSIBackfill = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "ack",
+			Subsystem: "dynamodb",
+			Name:      "tables_waiting_gsi_backfill",
+			Help: "Number of tables currently waiting for a global secondary " +
+				"index to finish creating or backfilling.",
+		},
+	)
+	// tableMutationSlotDenials counts reconciles that had to requeue because
+	// the --max-concurrent-table-mutations cap was reached.
+	tableMutationSlotDenials = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "ack",
+			Subsystem: "dynamodb",
+			Name:      "table_mutation_slot_denials_total",
+			Help: "Number of reconciles requeued because the concurrent " +
+				"table mutation cap was reached.",
+		},
+	)
+	// tableMutationSlotWaitSeconds observes how long a table waited between
+	// first being denied a mutation slot and acquiring one; zero when a slot
+	// was free immediately.
+	tableMutationSlotWaitSeconds = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "ack",
+			Subsystem: "dynamodb",
+			Name:      "table_mutation_slot_wait_seconds",
+			Help: "Seconds a table waited for a concurrent mutation slot " +
+				"before its mutating calls could start.",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+		},
+	)
+	// tableMutationsInFlight reports how many mutation slots are currently
+	// held.
+	tableMutationsInFlight = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "ack",
+			Subsystem: "dynamodb",
+			Name:      "table_mutations_in_flight",
+			Help: "Number of tables currently holding a concurrent mutation " +
+				"slot.",
+		},
+	)
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		sdkCallsTotal,
+		timeToActiveSeconds,
+		tablesWaitingGSIBackfill,
+		tableMutationSlotDenials,
+		tableMutationSlotWaitSeconds,
+		tableMutationsInFlight,
+	)
+}
+
+// recordSDKCall increments the SDK call counter for the given API operation.
+// The result label is "ok" on success, the AWS error code when the service
+// returned one, and "error" for anything else (e.g. transport failures).
+func recordSDKCall(operation string, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+		if awsErr, ok := ackerr.AWSError(err); ok {
+			result = awsErr.ErrorCode()
+		}
+	}
+	sdkCallsTotal.WithLabelValues(operation, result).Inc()
+}
+
+// transitionStartTimes remembers when each table was first observed in
+// CREATING or UPDATING so the time to ACTIVE can be observed once it
+// settles. Keyed by namespace/name.
+var transitionStartTimes sync.Map
+
+// trackTableTransition records state transitions for the time-to-ACTIVE
+// histogram. It is called every time the table has been described, with the
+// latest table status already set on the resource.
+func trackTableTransition(r *resource) {
+	if r.ko.Status.TableStatus == nil {
+		return
+	}
+	key := r.ko.Namespace + "/" + r.ko.Name
+	switch *r.ko.Status.TableStatus {
+	case string(v1alpha1.TableStatus_SDK_CREATING), string(v1alpha1.TableStatus_SDK_UPDATING):
+		if _, ok := transitionStartTimes.Load(key); !ok {
+			transitionStartTimes.Store(key, time.Now())
+		}
+	case string(v1alpha1.TableStatus_SDK_ACTIVE):
+		if started, ok := transitionStartTimes.LoadAndDelete(key); ok {
+			timeToActiveSeconds.WithLabelValues(r.ko.Namespace, r.ko.Name).
+				Observe(time.Since(started.(time.Time)).Seconds())
+		}
+	}
+}
+
+// gsiBackfillWaiters tracks which tables are currently counted in the GSI
+// backfill gauge so repeated reconciles do not skew it. Keyed by
+// namespace/name.
+var gsiBackfillWaiters sync.Map
+
+// setTableWaitingGSIBackfill adds or removes the table from the GSI backfill
+// gauge, keeping the gauge consistent when the same table is observed
+// waiting across several reconciles.
+func setTableWaitingGSIBackfill(r *resource, waiting bool) {
+	key := r.ko.Namespace + "/" + r.ko.Name
+	if waiting {
+		if _, loaded := gsiBackfillWaiters.LoadOrStore(key, struct{}{}); !loaded {
+			tablesWaitingGSIBackfill.Inc()
+		}
+		return
+	}
+	if _, loaded := gsiBackfillWaiters.LoadAndDelete(key); loaded {
+		tablesWaitingGSIBackfill.Dec()
+	}
+}
+
+// forgetTableMetrics drops the per-table tracking state once the table has
+// been deleted so the gauge and the transition map do not leak entries.
+func forgetTableMetrics(r *resource) {
+	key := r.ko.Namespace + "/" + r.ko.Name
+	transitionStartTimes.Delete(key)
+	tableProgressTimes.Delete(key)
+	mutationSlotWaitStarts.Delete(key)
+	if _, loaded := gsiBackfillWaiters.LoadAndDelete(key); loaded {
+		tablesWaitingGSIBackfill.Dec()
+	}
+}
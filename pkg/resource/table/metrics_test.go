@@ -0,0 +1,122 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package table
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	smithy "github.com/aws/smithy-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func testTableResource(namespace, name string) *resource {
+	ko := &v1alpha1.Table{}
+	ko.Namespace = namespace
+	ko.Name = name
+	ko.Spec.TableName = aws.String(name)
+	return &resource{ko: ko}
+}
+
+func Test_recordSDKCall(t *testing.T) {
+	// Register the package collectors with a fresh registry so the
+	// assertions below are not affected by what other tests recorded.
+	registry := prometheus.NewPedanticRegistry()
+	if err := registry.Register(sdkCallsTotal); err != nil {
+		t.Fatalf("registering sdkCallsTotal: %v", err)
+	}
+
+	okBefore := testutil.ToFloat64(sdkCallsTotal.WithLabelValues("UpdateTable", "ok"))
+	throttledBefore := testutil.ToFloat64(sdkCallsTotal.WithLabelValues("UpdateTable", "ThrottlingException"))
+	errorBefore := testutil.ToFloat64(sdkCallsTotal.WithLabelValues("TagResource", "error"))
+
+	recordSDKCall("UpdateTable", nil)
+	recordSDKCall("UpdateTable", &smithy.GenericAPIError{Code: "ThrottlingException"})
+	recordSDKCall("TagResource", errors.New("connection reset"))
+
+	if got := testutil.ToFloat64(sdkCallsTotal.WithLabelValues("UpdateTable", "ok")); got != okBefore+1 {
+		t.Errorf("ok counter = %v, want %v", got, okBefore+1)
+	}
+	if got := testutil.ToFloat64(sdkCallsTotal.WithLabelValues("UpdateTable", "ThrottlingException")); got != throttledBefore+1 {
+		t.Errorf("ThrottlingException counter = %v, want %v", got, throttledBefore+1)
+	}
+	if got := testutil.ToFloat64(sdkCallsTotal.WithLabelValues("TagResource", "error")); got != errorBefore+1 {
+		t.Errorf("error counter = %v, want %v", got, errorBefore+1)
+	}
+}
+
+func Test_trackTableTransition(t *testing.T) {
+	registry := prometheus.NewPedanticRegistry()
+	if err := registry.Register(timeToActiveSeconds); err != nil {
+		t.Fatalf("registering timeToActiveSeconds: %v", err)
+	}
+
+	r := testTableResource("default", "transition-test")
+	countBefore := testutil.CollectAndCount(timeToActiveSeconds)
+
+	// An ACTIVE table that was never seen transitioning records nothing.
+	r.ko.Status.TableStatus = aws.String(string(v1alpha1.TableStatus_SDK_ACTIVE))
+	trackTableTransition(r)
+	if got := testutil.CollectAndCount(timeToActiveSeconds); got != countBefore {
+		t.Errorf("histogram series count = %d, want %d", got, countBefore)
+	}
+
+	// CREATING starts the clock; the following ACTIVE observation stops it.
+	r.ko.Status.TableStatus = aws.String(string(v1alpha1.TableStatus_SDK_CREATING))
+	trackTableTransition(r)
+	if _, ok := transitionStartTimes.Load("default/transition-test"); !ok {
+		t.Fatal("expected a transition start time to be recorded for a CREATING table")
+	}
+	r.ko.Status.TableStatus = aws.String(string(v1alpha1.TableStatus_SDK_ACTIVE))
+	trackTableTransition(r)
+	if _, ok := transitionStartTimes.Load("default/transition-test"); ok {
+		t.Error("expected the transition start time to be cleared once ACTIVE")
+	}
+	if got := testutil.CollectAndCount(timeToActiveSeconds); got != countBefore+1 {
+		t.Errorf("histogram series count = %d, want %d", got, countBefore+1)
+	}
+}
+
+func Test_setTableWaitingGSIBackfill(t *testing.T) {
+	registry := prometheus.NewPedanticRegistry()
+	if err := registry.Register(tablesWaitingGSIBackfill); err != nil {
+		t.Fatalf("registering tablesWaitingGSIBackfill: %v", err)
+	}
+
+	r := testTableResource("default", "backfill-test")
+	before := testutil.ToFloat64(tablesWaitingGSIBackfill)
+
+	// Seeing the same table waiting twice only counts it once.
+	setTableWaitingGSIBackfill(r, true)
+	setTableWaitingGSIBackfill(r, true)
+	if got := testutil.ToFloat64(tablesWaitingGSIBackfill); got != before+1 {
+		t.Errorf("gauge = %v, want %v", got, before+1)
+	}
+
+	setTableWaitingGSIBackfill(r, false)
+	if got := testutil.ToFloat64(tablesWaitingGSIBackfill); got != before {
+		t.Errorf("gauge = %v, want %v", got, before)
+	}
+
+	// Deleting a table that was counted drops it from the gauge.
+	setTableWaitingGSIBackfill(r, true)
+	forgetTableMetrics(r)
+	if got := testutil.ToFloat64(tablesWaitingGSIBackfill); got != before {
+		t.Errorf("gauge after forgetTableMetrics = %v, want %v", got, before)
+	}
+}
@@ -0,0 +1,67 @@
+package table
+
+import (
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
+)
+
+func Test_validateReferenceFields(t *testing.T) {
+	kmsKeyRef := &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{
+			Name: aws.String("my-key"),
+		},
+	}
+	tests := []struct {
+		name    string
+		sseSpec *v1alpha1.SSESpecification
+		wantErr bool
+	}{
+		{
+			name:    "no SSE specification",
+			sseSpec: nil,
+			wantErr: false,
+		},
+		{
+			name: "literal key id only",
+			sseSpec: &v1alpha1.SSESpecification{
+				Enabled:        aws.Bool(true),
+				KMSMasterKeyID: aws.String("alias/dynamo-prod"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "key reference only",
+			sseSpec: &v1alpha1.SSESpecification{
+				Enabled:         aws.Bool(true),
+				KMSMasterKeyRef: kmsKeyRef,
+			},
+			wantErr: false,
+		},
+		{
+			name: "both reference and literal key id",
+			sseSpec: &v1alpha1.SSESpecification{
+				Enabled:         aws.Bool(true),
+				KMSMasterKeyID:  aws.String("alias/dynamo-prod"),
+				KMSMasterKeyRef: kmsKeyRef,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ko := &v1alpha1.Table{
+				Spec: v1alpha1.TableSpec{
+					TableName:        aws.String("table"),
+					SSESpecification: tt.sseSpec,
+				},
+			}
+			if err := validateReferenceFields(ko); (err != nil) != tt.wantErr {
+				t.Errorf("validateReferenceFields() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
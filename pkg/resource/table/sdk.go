@@ -63,6 +63,9 @@ func (rm *resourceManager) sdkFind(
 	defer func() {
 		exit(err)
 	}()
+	// Default the table name from the Kubernetes object name so a manifest
+	// that omits spec.tableName reads (and later creates) the right table.
+	defaultTableName(r)
 	// If any required fields in the input shape are missing, AWS resource is
 	// not created yet. Return NotFound here to indicate to callers that the
 	// resource isn't yet created.
@@ -152,25 +155,35 @@ func (rm *resourceManager) sdkFind(
 				}
 				f4elem.KeySchema = f4elemf6
 			}
+			if f4iter.OnDemandThroughput != nil {
+				f4elemf7 := &svcapitypes.OnDemandThroughput{}
+				if f4iter.OnDemandThroughput.MaxReadRequestUnits != nil {
+					f4elemf7.MaxReadRequestUnits = f4iter.OnDemandThroughput.MaxReadRequestUnits
+				}
+				if f4iter.OnDemandThroughput.MaxWriteRequestUnits != nil {
+					f4elemf7.MaxWriteRequestUnits = f4iter.OnDemandThroughput.MaxWriteRequestUnits
+				}
+				f4elem.OnDemandThroughput = f4elemf7
+			}
 			if f4iter.Projection != nil {
-				f4elemf7 := &svcapitypes.Projection{}
+				f4elemf8 := &svcapitypes.Projection{}
 				if f4iter.Projection.NonKeyAttributes != nil {
-					f4elemf7.NonKeyAttributes = aws.StringSlice(f4iter.Projection.NonKeyAttributes)
+					f4elemf8.NonKeyAttributes = aws.StringSlice(f4iter.Projection.NonKeyAttributes)
 				}
 				if f4iter.Projection.ProjectionType != "" {
-					f4elemf7.ProjectionType = aws.String(string(f4iter.Projection.ProjectionType))
+					f4elemf8.ProjectionType = aws.String(string(f4iter.Projection.ProjectionType))
 				}
-				f4elem.Projection = f4elemf7
+				f4elem.Projection = f4elemf8
 			}
 			if f4iter.ProvisionedThroughput != nil {
-				f4elemf8 := &svcapitypes.ProvisionedThroughput{}
+				f4elemf9 := &svcapitypes.ProvisionedThroughput{}
 				if f4iter.ProvisionedThroughput.ReadCapacityUnits != nil {
-					f4elemf8.ReadCapacityUnits = f4iter.ProvisionedThroughput.ReadCapacityUnits
+					f4elemf9.ReadCapacityUnits = f4iter.ProvisionedThroughput.ReadCapacityUnits
 				}
 				if f4iter.ProvisionedThroughput.WriteCapacityUnits != nil {
-					f4elemf8.WriteCapacityUnits = f4iter.ProvisionedThroughput.WriteCapacityUnits
+					f4elemf9.WriteCapacityUnits = f4iter.ProvisionedThroughput.WriteCapacityUnits
 				}
-				f4elem.ProvisionedThroughput = f4elemf8
+				f4elem.ProvisionedThroughput = f4elemf9
 			}
 			f4 = append(f4, f4elem)
 		}
@@ -251,108 +264,134 @@ func (rm *resourceManager) sdkFind(
 	} else {
 		ko.Spec.LocalSecondaryIndexes = nil
 	}
+	if resp.Table.OnDemandThroughput != nil {
+		f11 := &svcapitypes.OnDemandThroughput{}
+		if resp.Table.OnDemandThroughput.MaxReadRequestUnits != nil {
+			f11.MaxReadRequestUnits = resp.Table.OnDemandThroughput.MaxReadRequestUnits
+		}
+		if resp.Table.OnDemandThroughput.MaxWriteRequestUnits != nil {
+			f11.MaxWriteRequestUnits = resp.Table.OnDemandThroughput.MaxWriteRequestUnits
+		}
+		ko.Spec.OnDemandThroughput = f11
+	} else {
+		ko.Spec.OnDemandThroughput = nil
+	}
 	if resp.Table.ProvisionedThroughput != nil {
-		f11 := &svcapitypes.ProvisionedThroughput{}
+		f12 := &svcapitypes.ProvisionedThroughput{}
 		if resp.Table.ProvisionedThroughput.ReadCapacityUnits != nil {
-			f11.ReadCapacityUnits = resp.Table.ProvisionedThroughput.ReadCapacityUnits
+			f12.ReadCapacityUnits = resp.Table.ProvisionedThroughput.ReadCapacityUnits
 		}
 		if resp.Table.ProvisionedThroughput.WriteCapacityUnits != nil {
-			f11.WriteCapacityUnits = resp.Table.ProvisionedThroughput.WriteCapacityUnits
+			f12.WriteCapacityUnits = resp.Table.ProvisionedThroughput.WriteCapacityUnits
 		}
-		ko.Spec.ProvisionedThroughput = f11
+		ko.Spec.ProvisionedThroughput = f12
 	} else {
 		ko.Spec.ProvisionedThroughput = nil
 	}
 	if resp.Table.Replicas != nil {
-		f12 := []*svcapitypes.ReplicaDescription{}
-		for _, f12iter := range resp.Table.Replicas {
-			f12elem := &svcapitypes.ReplicaDescription{}
-			if f12iter.GlobalSecondaryIndexes != nil {
-				f12elemf0 := []*svcapitypes.ReplicaGlobalSecondaryIndexDescription{}
-				for _, f12elemf0iter := range f12iter.GlobalSecondaryIndexes {
-					f12elemf0elem := &svcapitypes.ReplicaGlobalSecondaryIndexDescription{}
-					if f12elemf0iter.IndexName != nil {
-						f12elemf0elem.IndexName = f12elemf0iter.IndexName
+		f13 := []*svcapitypes.ReplicaDescription{}
+		for _, f13iter := range resp.Table.Replicas {
+			f13elem := &svcapitypes.ReplicaDescription{}
+			if f13iter.GlobalSecondaryIndexes != nil {
+				f13elemf0 := []*svcapitypes.ReplicaGlobalSecondaryIndexDescription{}
+				for _, f13elemf0iter := range f13iter.GlobalSecondaryIndexes {
+					f13elemf0elem := &svcapitypes.ReplicaGlobalSecondaryIndexDescription{}
+					if f13elemf0iter.IndexName != nil {
+						f13elemf0elem.IndexName = f13elemf0iter.IndexName
 					}
-					if f12elemf0iter.ProvisionedThroughputOverride != nil {
-						f12elemf0elemf1 := &svcapitypes.ProvisionedThroughputOverride{}
-						if f12elemf0iter.ProvisionedThroughputOverride.ReadCapacityUnits != nil {
-							f12elemf0elemf1.ReadCapacityUnits = f12elemf0iter.ProvisionedThroughputOverride.ReadCapacityUnits
+					if f13elemf0iter.OnDemandThroughputOverride != nil {
+						f13elemf0elemf1 := &svcapitypes.OnDemandThroughputOverride{}
+						if f13elemf0iter.OnDemandThroughputOverride.MaxReadRequestUnits != nil {
+							f13elemf0elemf1.MaxReadRequestUnits = f13elemf0iter.OnDemandThroughputOverride.MaxReadRequestUnits
 						}
-						f12elemf0elem.ProvisionedThroughputOverride = f12elemf0elemf1
+						f13elemf0elem.OnDemandThroughputOverride = f13elemf0elemf1
 					}
-					f12elemf0 = append(f12elemf0, f12elemf0elem)
+					if f13elemf0iter.ProvisionedThroughputOverride != nil {
+						f13elemf0elemf2 := &svcapitypes.ProvisionedThroughputOverride{}
+						if f13elemf0iter.ProvisionedThroughputOverride.ReadCapacityUnits != nil {
+							f13elemf0elemf2.ReadCapacityUnits = f13elemf0iter.ProvisionedThroughputOverride.ReadCapacityUnits
+						}
+						f13elemf0elem.ProvisionedThroughputOverride = f13elemf0elemf2
+					}
+					f13elemf0 = append(f13elemf0, f13elemf0elem)
 				}
-				f12elem.GlobalSecondaryIndexes = f12elemf0
+				f13elem.GlobalSecondaryIndexes = f13elemf0
 			}
-			if f12iter.KMSMasterKeyId != nil {
-				f12elem.KMSMasterKeyID = f12iter.KMSMasterKeyId
+			if f13iter.KMSMasterKeyId != nil {
+				f13elem.KMSMasterKeyID = f13iter.KMSMasterKeyId
+			}
+			if f13iter.OnDemandThroughputOverride != nil {
+				f13elemf2 := &svcapitypes.OnDemandThroughputOverride{}
+				if f13iter.OnDemandThroughputOverride.MaxReadRequestUnits != nil {
+					f13elemf2.MaxReadRequestUnits = f13iter.OnDemandThroughputOverride.MaxReadRequestUnits
+				}
+				f13elem.OnDemandThroughputOverride = f13elemf2
 			}
-			if f12iter.ProvisionedThroughputOverride != nil {
-				f12elemf2 := &svcapitypes.ProvisionedThroughputOverride{}
-				if f12iter.ProvisionedThroughputOverride.ReadCapacityUnits != nil {
-					f12elemf2.ReadCapacityUnits = f12iter.ProvisionedThroughputOverride.ReadCapacityUnits
+			if f13iter.ProvisionedThroughputOverride != nil {
+				f13elemf3 := &svcapitypes.ProvisionedThroughputOverride{}
+				if f13iter.ProvisionedThroughputOverride.ReadCapacityUnits != nil {
+					f13elemf3.ReadCapacityUnits = f13iter.ProvisionedThroughputOverride.ReadCapacityUnits
 				}
-				f12elem.ProvisionedThroughputOverride = f12elemf2
+				f13elem.ProvisionedThroughputOverride = f13elemf3
 			}
-			if f12iter.RegionName != nil {
-				f12elem.RegionName = f12iter.RegionName
+			if f13iter.RegionName != nil {
+				f13elem.RegionName = f13iter.RegionName
 			}
-			if f12iter.ReplicaInaccessibleDateTime != nil {
-				f12elem.ReplicaInaccessibleDateTime = &metav1.Time{*f12iter.ReplicaInaccessibleDateTime}
+			if f13iter.ReplicaInaccessibleDateTime != nil {
+				f13elem.ReplicaInaccessibleDateTime = &metav1.Time{*f13iter.ReplicaInaccessibleDateTime}
 			}
-			if f12iter.ReplicaStatus != "" {
-				f12elem.ReplicaStatus = aws.String(string(f12iter.ReplicaStatus))
+			if f13iter.ReplicaStatus != "" {
+				f13elem.ReplicaStatus = aws.String(string(f13iter.ReplicaStatus))
 			}
-			if f12iter.ReplicaStatusDescription != nil {
-				f12elem.ReplicaStatusDescription = f12iter.ReplicaStatusDescription
+			if f13iter.ReplicaStatusDescription != nil {
+				f13elem.ReplicaStatusDescription = f13iter.ReplicaStatusDescription
 			}
-			if f12iter.ReplicaStatusPercentProgress != nil {
-				f12elem.ReplicaStatusPercentProgress = f12iter.ReplicaStatusPercentProgress
+			if f13iter.ReplicaStatusPercentProgress != nil {
+				f13elem.ReplicaStatusPercentProgress = f13iter.ReplicaStatusPercentProgress
 			}
-			if f12iter.ReplicaTableClassSummary != nil {
-				f12elemf8 := &svcapitypes.TableClassSummary{}
-				if f12iter.ReplicaTableClassSummary.LastUpdateDateTime != nil {
-					f12elemf8.LastUpdateDateTime = &metav1.Time{*f12iter.ReplicaTableClassSummary.LastUpdateDateTime}
+			if f13iter.ReplicaTableClassSummary != nil {
+				f13elemf9 := &svcapitypes.TableClassSummary{}
+				if f13iter.ReplicaTableClassSummary.LastUpdateDateTime != nil {
+					f13elemf9.LastUpdateDateTime = &metav1.Time{*f13iter.ReplicaTableClassSummary.LastUpdateDateTime}
 				}
-				if f12iter.ReplicaTableClassSummary.TableClass != "" {
-					f12elemf8.TableClass = aws.String(string(f12iter.ReplicaTableClassSummary.TableClass))
+				if f13iter.ReplicaTableClassSummary.TableClass != "" {
+					f13elemf9.TableClass = aws.String(string(f13iter.ReplicaTableClassSummary.TableClass))
 				}
-				f12elem.ReplicaTableClassSummary = f12elemf8
+				f13elem.ReplicaTableClassSummary = f13elemf9
 			}
-			f12 = append(f12, f12elem)
+			f13 = append(f13, f13elem)
 		}
-		ko.Status.Replicas = f12
+		ko.Status.Replicas = f13
 	} else {
 		ko.Status.Replicas = nil
 	}
 	if resp.Table.RestoreSummary != nil {
-		f13 := &svcapitypes.RestoreSummary{}
+		f14 := &svcapitypes.RestoreSummary{}
 		if resp.Table.RestoreSummary.RestoreDateTime != nil {
-			f13.RestoreDateTime = &metav1.Time{*resp.Table.RestoreSummary.RestoreDateTime}
+			f14.RestoreDateTime = &metav1.Time{*resp.Table.RestoreSummary.RestoreDateTime}
 		}
 		if resp.Table.RestoreSummary.RestoreInProgress != nil {
-			f13.RestoreInProgress = resp.Table.RestoreSummary.RestoreInProgress
+			f14.RestoreInProgress = resp.Table.RestoreSummary.RestoreInProgress
 		}
 		if resp.Table.RestoreSummary.SourceBackupArn != nil {
-			f13.SourceBackupARN = resp.Table.RestoreSummary.SourceBackupArn
+			f14.SourceBackupARN = resp.Table.RestoreSummary.SourceBackupArn
 		}
 		if resp.Table.RestoreSummary.SourceTableArn != nil {
-			f13.SourceTableARN = resp.Table.RestoreSummary.SourceTableArn
+			f14.SourceTableARN = resp.Table.RestoreSummary.SourceTableArn
 		}
-		ko.Status.RestoreSummary = f13
+		ko.Status.RestoreSummary = f14
 	} else {
 		ko.Status.RestoreSummary = nil
 	}
 	if resp.Table.StreamSpecification != nil {
-		f14 := &svcapitypes.StreamSpecification{}
+		f15 := &svcapitypes.StreamSpecification{}
 		if resp.Table.StreamSpecification.StreamEnabled != nil {
-			f14.StreamEnabled = resp.Table.StreamSpecification.StreamEnabled
+			f15.StreamEnabled = resp.Table.StreamSpecification.StreamEnabled
 		}
 		if resp.Table.StreamSpecification.StreamViewType != "" {
-			f14.StreamViewType = aws.String(string(resp.Table.StreamSpecification.StreamViewType))
+			f15.StreamViewType = aws.String(string(resp.Table.StreamSpecification.StreamViewType))
 		}
-		ko.Spec.StreamSpecification = f14
+		ko.Spec.StreamSpecification = f15
 	} else {
 		ko.Spec.StreamSpecification = nil
 	}
@@ -385,33 +424,36 @@ func (rm *resourceManager) sdkFind(
 	}
 
 	rm.setStatusDefaults(ko)
-	if resp.Table.GlobalSecondaryIndexes != nil {
-		f := []*svcapitypes.GlobalSecondaryIndexDescription{}
-		for _, fIter := range resp.Table.GlobalSecondaryIndexes {
-			fElem := &svcapitypes.GlobalSecondaryIndexDescription{}
+	if isTableDeleting(&resource{ko}) {
+		// A table in DELETING status is on its way out; report it as not
+		// found so a pending recreate waits for the name to free up instead
+		// of diffing against the doomed table.
+		return nil, ackerr.NotFound
+	}
+	// Record every index's description - including its ARN, which
+	// FieldExport consumers rely on - each time the table is read.
+	ko.Status.GlobalSecondaryIndexesDescriptions = gsiDescriptionsFromSDK(resp.Table.GlobalSecondaryIndexes)
+	if resp.Table.LocalSecondaryIndexes != nil {
+		f := []*svcapitypes.LocalSecondaryIndexDescription{}
+		for _, fIter := range resp.Table.LocalSecondaryIndexes {
+			fElem := &svcapitypes.LocalSecondaryIndexDescription{}
 			if fIter.IndexName != nil {
 				fElem.IndexName = fIter.IndexName
 			}
 			if fIter.IndexArn != nil {
 				fElem.IndexARN = fIter.IndexArn
 			}
-			if fIter.ItemCount != nil {
-				fElem.ItemCount = fIter.ItemCount
-			}
-			if fIter.IndexStatus != "" {
-				fElem.IndexStatus = aws.String(string(fIter.IndexStatus))
-			}
 			if fIter.IndexSizeBytes != nil {
 				fElem.IndexSizeBytes = fIter.IndexSizeBytes
 			}
-			if fIter.Backfilling != nil {
-				fElem.Backfilling = fIter.Backfilling
+			if fIter.ItemCount != nil {
+				fElem.ItemCount = fIter.ItemCount
 			}
 			f = append(f, fElem)
 		}
-		ko.Status.GlobalSecondaryIndexesDescriptions = f
+		ko.Status.LocalSecondaryIndexesDescriptions = f
 	} else {
-		ko.Status.GlobalSecondaryIndexesDescriptions = nil
+		ko.Status.LocalSecondaryIndexesDescriptions = nil
 	}
 	if resp.Table.SSEDescription != nil {
 		f := &svcapitypes.SSESpecification{}
@@ -427,35 +469,137 @@ func (rm *resourceManager) sdkFind(
 			f.KMSMasterKeyID = resp.Table.SSEDescription.KMSMasterKeyArn
 		}
 		ko.Spec.SSESpecification = f
+		f1 := &svcapitypes.SSEDescription{}
+		if resp.Table.SSEDescription.Status != "" {
+			f1.Status = aws.String(string(resp.Table.SSEDescription.Status))
+		}
+		if resp.Table.SSEDescription.SSEType != "" {
+			f1.SSEType = aws.String(string(resp.Table.SSEDescription.SSEType))
+		}
+		if resp.Table.SSEDescription.KMSMasterKeyArn != nil {
+			f1.KMSMasterKeyARN = resp.Table.SSEDescription.KMSMasterKeyArn
+		}
+		if resp.Table.SSEDescription.InaccessibleEncryptionDateTime != nil {
+			f1.InaccessibleEncryptionDateTime = &metav1.Time{*resp.Table.SSEDescription.InaccessibleEncryptionDateTime}
+		}
+		ko.Status.SSEDescription = f1
 	} else {
 		ko.Spec.SSESpecification = nil
+		ko.Status.SSEDescription = nil
 	}
 	if resp.Table.TableClassSummary != nil {
+		f := &svcapitypes.TableClassSummary{}
+		if resp.Table.TableClassSummary.LastUpdateDateTime != nil {
+			f.LastUpdateDateTime = &metav1.Time{*resp.Table.TableClassSummary.LastUpdateDateTime}
+		}
+		if resp.Table.TableClassSummary.TableClass != "" {
+			f.TableClass = aws.String(string(resp.Table.TableClassSummary.TableClass))
+		}
+		ko.Status.TableClassSummary = f
 		ko.Spec.TableClass = aws.String(string(resp.Table.TableClassSummary.TableClass))
 	} else {
+		ko.Status.TableClassSummary = nil
 		ko.Spec.TableClass = aws.String("STANDARD")
 	}
+	if resp.Table.BillingModeSummary != nil {
+		f := &svcapitypes.BillingModeSummary{}
+		if resp.Table.BillingModeSummary.BillingMode != "" {
+			f.BillingMode = aws.String(string(resp.Table.BillingModeSummary.BillingMode))
+		}
+		if resp.Table.BillingModeSummary.LastUpdateToPayPerRequestDateTime != nil {
+			f.LastUpdateToPayPerRequestDateTime = &metav1.Time{*resp.Table.BillingModeSummary.LastUpdateToPayPerRequestDateTime}
+		}
+		ko.Status.BillingModeSummary = f
+	} else {
+		ko.Status.BillingModeSummary = nil
+	}
 	if resp.Table.BillingModeSummary != nil && resp.Table.BillingModeSummary.BillingMode != "" {
 		ko.Spec.BillingMode = aws.String(string(resp.Table.BillingModeSummary.BillingMode))
 	} else {
 		ko.Spec.BillingMode = aws.String("PROVISIONED")
 	}
+	// Mirror the authoritative deletion protection state and the provisioned
+	// throughput description for drift auditing; the spec-side fields only
+	// carry the desired values.
+	ko.Status.DeletionProtectionEnabled = resp.Table.DeletionProtectionEnabled
+	if resp.Table.ProvisionedThroughput != nil {
+		f := &svcapitypes.ProvisionedThroughputDescription{}
+		if resp.Table.ProvisionedThroughput.LastDecreaseDateTime != nil {
+			f.LastDecreaseDateTime = &metav1.Time{*resp.Table.ProvisionedThroughput.LastDecreaseDateTime}
+		}
+		if resp.Table.ProvisionedThroughput.LastIncreaseDateTime != nil {
+			f.LastIncreaseDateTime = &metav1.Time{*resp.Table.ProvisionedThroughput.LastIncreaseDateTime}
+		}
+		f.NumberOfDecreasesToday = resp.Table.ProvisionedThroughput.NumberOfDecreasesToday
+		f.ReadCapacityUnits = resp.Table.ProvisionedThroughput.ReadCapacityUnits
+		f.WriteCapacityUnits = resp.Table.ProvisionedThroughput.WriteCapacityUnits
+		ko.Status.ProvisionedThroughputDescription = f
+	} else {
+		ko.Status.ProvisionedThroughputDescription = nil
+	}
+	if resp.Table.MultiRegionConsistency != "" {
+		ko.Status.MultiRegionConsistency = aws.String(string(resp.Table.MultiRegionConsistency))
+	} else {
+		ko.Status.MultiRegionConsistency = nil
+	}
+	if resp.Table.WarmThroughput != nil {
+		wt := &svcapitypes.TableWarmThroughputDescription{}
+		if resp.Table.WarmThroughput.ReadUnitsPerSecond != nil {
+			wt.ReadUnitsPerSecond = resp.Table.WarmThroughput.ReadUnitsPerSecond
+		}
+		if resp.Table.WarmThroughput.WriteUnitsPerSecond != nil {
+			wt.WriteUnitsPerSecond = resp.Table.WarmThroughput.WriteUnitsPerSecond
+		}
+		if resp.Table.WarmThroughput.Status != "" {
+			wt.Status = aws.String(string(resp.Table.WarmThroughput.Status))
+		}
+		ko.Status.WarmThroughputDescription = wt
+	} else {
+		ko.Status.WarmThroughputDescription = nil
+	}
+	setGlobalSecondaryIndexWarmThroughputs(ko, resp.Table.GlobalSecondaryIndexes)
 	setTableReplicas(ko, resp.Table.Replicas)
+	trackTableTransition(&resource{ko})
+	checkTableProgressDeadline(&resource{ko})
+	if ko.Status.Import != nil && (ko.Status.Import.ImportStatus == nil ||
+		*ko.Status.Import.ImportStatus != string(svcsdktypes.ImportStatusCompleted)) {
+		if err := rm.syncImportStatus(ctx, &resource{ko}); err != nil {
+			return &resource{ko}, err
+		}
+	}
 	if isTableCreating(&resource{ko}) {
-		return &resource{ko}, requeueWaitWhileCreating
+		return &resource{ko}, overrideRequeueDelay(&resource{ko}, requeueWaitWhileCreating)
 	}
+	updateGSIsInSyncCondition(&resource{ko})
+	setTableWaitingGSIBackfill(&resource{ko}, !canUpdateTableGSIs(&resource{ko}))
 	if !canUpdateTableGSIs(&resource{ko}) {
-		return &resource{ko}, requeueWaitGSIReady
+		return &resource{ko}, overrideRequeueDelay(&resource{ko}, requeueWaitGSIReady)
+	}
+	if tableReplicasTransitioning(&resource{ko}) {
+		// ResourceSynced must not flip to True while replicas are still
+		// settling; keep requeueing until every replica reports ACTIVE.
+		msg := "table replicas are not yet ACTIVE"
+		setSyncedCondition(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+		return &resource{ko}, overrideRequeueDelay(&resource{ko}, requeueWaitReplicasActive)
 	}
 	if err = rm.setContributorInsights(ctx, ko); err != nil {
-		return &resource{ko}, err
+		return &resource{ko}, mapAPIError(err)
+	}
+	if err = rm.setKinesisStreamingDestinations(ctx, ko); err != nil {
+		return &resource{ko}, mapAPIError(err)
 	}
 	if isTableUpdating(&resource{ko}) || isTableContributorInsightsUpdating(&resource{ko}) {
-		return &resource{ko}, requeueWaitWhileUpdating
+		return &resource{ko}, overrideRequeueDelay(&resource{ko}, requeueWaitWhileUpdating)
+	}
+	if kinesisDestinationsTransitioning(&resource{ko}) {
+		return &resource{ko}, requeueWaitKinesisDestinationActive
 	}
 
 	if err := rm.setResourceAdditionalFields(ctx, ko); err != nil {
-		return nil, err
+		// The auxiliary reads go through the central error classification,
+		// so e.g. a missing IAM permission on DescribeTimeToLive goes
+		// terminal instead of retrying forever.
+		return nil, mapAPIError(err)
 	}
 
 	return &resource{ko}, nil
@@ -497,15 +641,65 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	// Default the table name from the Kubernetes object name so manifests can
+	// omit spec.tableName; the defaulted value is written back into the spec
+	// where the immutability check keeps guarding it.
+	defaultTableName(desired)
+	if err := validateTableSpecLimits(desired); err != nil {
+		return nil, err
+	}
+	// Cap the number of simultaneous mutating operations across all tables;
+	// the create (or restore/import) call below is a mutation.
+	releaseMutationSlot, ok := acquireMutationSlot(desired)
+	if !ok {
+		return nil, requeueWaitMutationSlot
+	}
+	defer releaseMutationSlot()
+	if desired.ko.Spec.RestoreSourceBackupARN != nil {
+		return rm.restoreTableFromBackup(ctx, desired)
+	}
+	if desired.ko.Spec.RestoreFrom != nil {
+		return rm.restoreTableToPointInTime(ctx, desired)
+	}
+	if desired.ko.Spec.ImportSourceSpecification != nil {
+		return rm.importTable(ctx, desired)
+	}
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
 	}
+	// PAY_PER_REQUEST tables have no provisioned capacity to configure; drop
+	// any stored throughput values from the create call (warning once about
+	// the ignored fields) instead of rejecting the spec.
+	stripOnDemandIgnoredThroughput(input, desired)
 
 	var resp *svcsdk.CreateTableOutput
 	_ = resp
 	resp, err = rm.sdkapi.CreateTable(ctx, input)
 	rm.metrics.RecordAPICall("CREATE", "CreateTable", err)
+	recordSDKCall("CreateTable", err)
+	if err != nil && isResourceInUseException(err) && hasAdoptOrCreatePolicy(desired) {
+		// The table already exists and the user asked for it to be adopted:
+		// read it back (including GSIs, TTL, PITR, tags and stream settings)
+		// and hand it to the normal drift reconciliation instead of erroring.
+		return rm.sdkFind(ctx, desired)
+	}
+	if err != nil && isResourceInUseException(err) && rm.isTableDeletingServerSide(ctx, desired) {
+		// A previous table with the same name is still being deleted; wait
+		// for the name to free up and retry the create instead of surfacing
+		// an error.
+		return nil, requeueWaitWhileDeleting
+	}
+	if err != nil && isThrottlingError(err) {
+		rlog.Info("throttled by DynamoDB control plane", "error", err)
+		return nil, throttleRequeue(err)
+	}
+	if err != nil && classifyAPIError(err) != errorClassRetryNormal {
+		// Classify anything else centrally: IAM and validation failures go
+		// terminal (with the AWS request ID in the condition message) and
+		// busy-table errors requeue quickly.
+		return nil, mapAPIError(err)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -575,25 +769,35 @@ func (rm *resourceManager) sdkCreate(
 				}
 				f4elem.KeySchema = f4elemf6
 			}
+			if f4iter.OnDemandThroughput != nil {
+				f4elemf7 := &svcapitypes.OnDemandThroughput{}
+				if f4iter.OnDemandThroughput.MaxReadRequestUnits != nil {
+					f4elemf7.MaxReadRequestUnits = f4iter.OnDemandThroughput.MaxReadRequestUnits
+				}
+				if f4iter.OnDemandThroughput.MaxWriteRequestUnits != nil {
+					f4elemf7.MaxWriteRequestUnits = f4iter.OnDemandThroughput.MaxWriteRequestUnits
+				}
+				f4elem.OnDemandThroughput = f4elemf7
+			}
 			if f4iter.Projection != nil {
-				f4elemf7 := &svcapitypes.Projection{}
+				f4elemf8 := &svcapitypes.Projection{}
 				if f4iter.Projection.NonKeyAttributes != nil {
-					f4elemf7.NonKeyAttributes = aws.StringSlice(f4iter.Projection.NonKeyAttributes)
+					f4elemf8.NonKeyAttributes = aws.StringSlice(f4iter.Projection.NonKeyAttributes)
 				}
 				if f4iter.Projection.ProjectionType != "" {
-					f4elemf7.ProjectionType = aws.String(string(f4iter.Projection.ProjectionType))
+					f4elemf8.ProjectionType = aws.String(string(f4iter.Projection.ProjectionType))
 				}
-				f4elem.Projection = f4elemf7
+				f4elem.Projection = f4elemf8
 			}
 			if f4iter.ProvisionedThroughput != nil {
-				f4elemf8 := &svcapitypes.ProvisionedThroughput{}
+				f4elemf9 := &svcapitypes.ProvisionedThroughput{}
 				if f4iter.ProvisionedThroughput.ReadCapacityUnits != nil {
-					f4elemf8.ReadCapacityUnits = f4iter.ProvisionedThroughput.ReadCapacityUnits
+					f4elemf9.ReadCapacityUnits = f4iter.ProvisionedThroughput.ReadCapacityUnits
 				}
 				if f4iter.ProvisionedThroughput.WriteCapacityUnits != nil {
-					f4elemf8.WriteCapacityUnits = f4iter.ProvisionedThroughput.WriteCapacityUnits
+					f4elemf9.WriteCapacityUnits = f4iter.ProvisionedThroughput.WriteCapacityUnits
 				}
-				f4elem.ProvisionedThroughput = f4elemf8
+				f4elem.ProvisionedThroughput = f4elemf9
 			}
 			f4 = append(f4, f4elem)
 		}
@@ -674,108 +878,134 @@ func (rm *resourceManager) sdkCreate(
 	} else {
 		ko.Spec.LocalSecondaryIndexes = nil
 	}
+	if resp.TableDescription.OnDemandThroughput != nil {
+		f11 := &svcapitypes.OnDemandThroughput{}
+		if resp.TableDescription.OnDemandThroughput.MaxReadRequestUnits != nil {
+			f11.MaxReadRequestUnits = resp.TableDescription.OnDemandThroughput.MaxReadRequestUnits
+		}
+		if resp.TableDescription.OnDemandThroughput.MaxWriteRequestUnits != nil {
+			f11.MaxWriteRequestUnits = resp.TableDescription.OnDemandThroughput.MaxWriteRequestUnits
+		}
+		ko.Spec.OnDemandThroughput = f11
+	} else {
+		ko.Spec.OnDemandThroughput = nil
+	}
 	if resp.TableDescription.ProvisionedThroughput != nil {
-		f11 := &svcapitypes.ProvisionedThroughput{}
+		f12 := &svcapitypes.ProvisionedThroughput{}
 		if resp.TableDescription.ProvisionedThroughput.ReadCapacityUnits != nil {
-			f11.ReadCapacityUnits = resp.TableDescription.ProvisionedThroughput.ReadCapacityUnits
+			f12.ReadCapacityUnits = resp.TableDescription.ProvisionedThroughput.ReadCapacityUnits
 		}
 		if resp.TableDescription.ProvisionedThroughput.WriteCapacityUnits != nil {
-			f11.WriteCapacityUnits = resp.TableDescription.ProvisionedThroughput.WriteCapacityUnits
+			f12.WriteCapacityUnits = resp.TableDescription.ProvisionedThroughput.WriteCapacityUnits
 		}
-		ko.Spec.ProvisionedThroughput = f11
+		ko.Spec.ProvisionedThroughput = f12
 	} else {
 		ko.Spec.ProvisionedThroughput = nil
 	}
 	if resp.TableDescription.Replicas != nil {
-		f12 := []*svcapitypes.ReplicaDescription{}
-		for _, f12iter := range resp.TableDescription.Replicas {
-			f12elem := &svcapitypes.ReplicaDescription{}
-			if f12iter.GlobalSecondaryIndexes != nil {
-				f12elemf0 := []*svcapitypes.ReplicaGlobalSecondaryIndexDescription{}
-				for _, f12elemf0iter := range f12iter.GlobalSecondaryIndexes {
-					f12elemf0elem := &svcapitypes.ReplicaGlobalSecondaryIndexDescription{}
-					if f12elemf0iter.IndexName != nil {
-						f12elemf0elem.IndexName = f12elemf0iter.IndexName
+		f13 := []*svcapitypes.ReplicaDescription{}
+		for _, f13iter := range resp.TableDescription.Replicas {
+			f13elem := &svcapitypes.ReplicaDescription{}
+			if f13iter.GlobalSecondaryIndexes != nil {
+				f13elemf0 := []*svcapitypes.ReplicaGlobalSecondaryIndexDescription{}
+				for _, f13elemf0iter := range f13iter.GlobalSecondaryIndexes {
+					f13elemf0elem := &svcapitypes.ReplicaGlobalSecondaryIndexDescription{}
+					if f13elemf0iter.IndexName != nil {
+						f13elemf0elem.IndexName = f13elemf0iter.IndexName
 					}
-					if f12elemf0iter.ProvisionedThroughputOverride != nil {
-						f12elemf0elemf1 := &svcapitypes.ProvisionedThroughputOverride{}
-						if f12elemf0iter.ProvisionedThroughputOverride.ReadCapacityUnits != nil {
-							f12elemf0elemf1.ReadCapacityUnits = f12elemf0iter.ProvisionedThroughputOverride.ReadCapacityUnits
+					if f13elemf0iter.OnDemandThroughputOverride != nil {
+						f13elemf0elemf1 := &svcapitypes.OnDemandThroughputOverride{}
+						if f13elemf0iter.OnDemandThroughputOverride.MaxReadRequestUnits != nil {
+							f13elemf0elemf1.MaxReadRequestUnits = f13elemf0iter.OnDemandThroughputOverride.MaxReadRequestUnits
 						}
-						f12elemf0elem.ProvisionedThroughputOverride = f12elemf0elemf1
+						f13elemf0elem.OnDemandThroughputOverride = f13elemf0elemf1
 					}
-					f12elemf0 = append(f12elemf0, f12elemf0elem)
+					if f13elemf0iter.ProvisionedThroughputOverride != nil {
+						f13elemf0elemf2 := &svcapitypes.ProvisionedThroughputOverride{}
+						if f13elemf0iter.ProvisionedThroughputOverride.ReadCapacityUnits != nil {
+							f13elemf0elemf2.ReadCapacityUnits = f13elemf0iter.ProvisionedThroughputOverride.ReadCapacityUnits
+						}
+						f13elemf0elem.ProvisionedThroughputOverride = f13elemf0elemf2
+					}
+					f13elemf0 = append(f13elemf0, f13elemf0elem)
 				}
-				f12elem.GlobalSecondaryIndexes = f12elemf0
+				f13elem.GlobalSecondaryIndexes = f13elemf0
+			}
+			if f13iter.KMSMasterKeyId != nil {
+				f13elem.KMSMasterKeyID = f13iter.KMSMasterKeyId
 			}
-			if f12iter.KMSMasterKeyId != nil {
-				f12elem.KMSMasterKeyID = f12iter.KMSMasterKeyId
+			if f13iter.OnDemandThroughputOverride != nil {
+				f13elemf2 := &svcapitypes.OnDemandThroughputOverride{}
+				if f13iter.OnDemandThroughputOverride.MaxReadRequestUnits != nil {
+					f13elemf2.MaxReadRequestUnits = f13iter.OnDemandThroughputOverride.MaxReadRequestUnits
+				}
+				f13elem.OnDemandThroughputOverride = f13elemf2
 			}
-			if f12iter.ProvisionedThroughputOverride != nil {
-				f12elemf2 := &svcapitypes.ProvisionedThroughputOverride{}
-				if f12iter.ProvisionedThroughputOverride.ReadCapacityUnits != nil {
-					f12elemf2.ReadCapacityUnits = f12iter.ProvisionedThroughputOverride.ReadCapacityUnits
+			if f13iter.ProvisionedThroughputOverride != nil {
+				f13elemf3 := &svcapitypes.ProvisionedThroughputOverride{}
+				if f13iter.ProvisionedThroughputOverride.ReadCapacityUnits != nil {
+					f13elemf3.ReadCapacityUnits = f13iter.ProvisionedThroughputOverride.ReadCapacityUnits
 				}
-				f12elem.ProvisionedThroughputOverride = f12elemf2
+				f13elem.ProvisionedThroughputOverride = f13elemf3
 			}
-			if f12iter.RegionName != nil {
-				f12elem.RegionName = f12iter.RegionName
+			if f13iter.RegionName != nil {
+				f13elem.RegionName = f13iter.RegionName
 			}
-			if f12iter.ReplicaInaccessibleDateTime != nil {
-				f12elem.ReplicaInaccessibleDateTime = &metav1.Time{*f12iter.ReplicaInaccessibleDateTime}
+			if f13iter.ReplicaInaccessibleDateTime != nil {
+				f13elem.ReplicaInaccessibleDateTime = &metav1.Time{*f13iter.ReplicaInaccessibleDateTime}
 			}
-			if f12iter.ReplicaStatus != "" {
-				f12elem.ReplicaStatus = aws.String(string(f12iter.ReplicaStatus))
+			if f13iter.ReplicaStatus != "" {
+				f13elem.ReplicaStatus = aws.String(string(f13iter.ReplicaStatus))
 			}
-			if f12iter.ReplicaStatusDescription != nil {
-				f12elem.ReplicaStatusDescription = f12iter.ReplicaStatusDescription
+			if f13iter.ReplicaStatusDescription != nil {
+				f13elem.ReplicaStatusDescription = f13iter.ReplicaStatusDescription
 			}
-			if f12iter.ReplicaStatusPercentProgress != nil {
-				f12elem.ReplicaStatusPercentProgress = f12iter.ReplicaStatusPercentProgress
+			if f13iter.ReplicaStatusPercentProgress != nil {
+				f13elem.ReplicaStatusPercentProgress = f13iter.ReplicaStatusPercentProgress
 			}
-			if f12iter.ReplicaTableClassSummary != nil {
-				f12elemf8 := &svcapitypes.TableClassSummary{}
-				if f12iter.ReplicaTableClassSummary.LastUpdateDateTime != nil {
-					f12elemf8.LastUpdateDateTime = &metav1.Time{*f12iter.ReplicaTableClassSummary.LastUpdateDateTime}
+			if f13iter.ReplicaTableClassSummary != nil {
+				f13elemf9 := &svcapitypes.TableClassSummary{}
+				if f13iter.ReplicaTableClassSummary.LastUpdateDateTime != nil {
+					f13elemf9.LastUpdateDateTime = &metav1.Time{*f13iter.ReplicaTableClassSummary.LastUpdateDateTime}
 				}
-				if f12iter.ReplicaTableClassSummary.TableClass != "" {
-					f12elemf8.TableClass = aws.String(string(f12iter.ReplicaTableClassSummary.TableClass))
+				if f13iter.ReplicaTableClassSummary.TableClass != "" {
+					f13elemf9.TableClass = aws.String(string(f13iter.ReplicaTableClassSummary.TableClass))
 				}
-				f12elem.ReplicaTableClassSummary = f12elemf8
+				f13elem.ReplicaTableClassSummary = f13elemf9
 			}
-			f12 = append(f12, f12elem)
+			f13 = append(f13, f13elem)
 		}
-		ko.Status.Replicas = f12
+		ko.Status.Replicas = f13
 	} else {
 		ko.Status.Replicas = nil
 	}
 	if resp.TableDescription.RestoreSummary != nil {
-		f13 := &svcapitypes.RestoreSummary{}
+		f14 := &svcapitypes.RestoreSummary{}
 		if resp.TableDescription.RestoreSummary.RestoreDateTime != nil {
-			f13.RestoreDateTime = &metav1.Time{*resp.TableDescription.RestoreSummary.RestoreDateTime}
+			f14.RestoreDateTime = &metav1.Time{*resp.TableDescription.RestoreSummary.RestoreDateTime}
 		}
 		if resp.TableDescription.RestoreSummary.RestoreInProgress != nil {
-			f13.RestoreInProgress = resp.TableDescription.RestoreSummary.RestoreInProgress
+			f14.RestoreInProgress = resp.TableDescription.RestoreSummary.RestoreInProgress
 		}
 		if resp.TableDescription.RestoreSummary.SourceBackupArn != nil {
-			f13.SourceBackupARN = resp.TableDescription.RestoreSummary.SourceBackupArn
+			f14.SourceBackupARN = resp.TableDescription.RestoreSummary.SourceBackupArn
 		}
 		if resp.TableDescription.RestoreSummary.SourceTableArn != nil {
-			f13.SourceTableARN = resp.TableDescription.RestoreSummary.SourceTableArn
+			f14.SourceTableARN = resp.TableDescription.RestoreSummary.SourceTableArn
 		}
-		ko.Status.RestoreSummary = f13
+		ko.Status.RestoreSummary = f14
 	} else {
 		ko.Status.RestoreSummary = nil
 	}
 	if resp.TableDescription.StreamSpecification != nil {
-		f14 := &svcapitypes.StreamSpecification{}
+		f15 := &svcapitypes.StreamSpecification{}
 		if resp.TableDescription.StreamSpecification.StreamEnabled != nil {
-			f14.StreamEnabled = resp.TableDescription.StreamSpecification.StreamEnabled
+			f15.StreamEnabled = resp.TableDescription.StreamSpecification.StreamEnabled
 		}
 		if resp.TableDescription.StreamSpecification.StreamViewType != "" {
-			f14.StreamViewType = aws.String(string(resp.TableDescription.StreamSpecification.StreamViewType))
+			f15.StreamViewType = aws.String(string(resp.TableDescription.StreamSpecification.StreamViewType))
 		}
-		ko.Spec.StreamSpecification = f14
+		ko.Spec.StreamSpecification = f15
 	} else {
 		ko.Spec.StreamSpecification = nil
 	}
@@ -808,8 +1038,19 @@ func (rm *resourceManager) sdkCreate(
 	}
 
 	rm.setStatusDefaults(ko)
+	// CreateTable already returns the descriptions of the secondary indexes
+	// (including their ARNs) alongside the table and stream ARNs; record
+	// them now so the status is complete on the first post-create reconcile
+	// instead of waiting for the next read.
+	ko.Status.GlobalSecondaryIndexesDescriptions = gsiDescriptionsFromSDK(resp.TableDescription.GlobalSecondaryIndexes)
 	// handle in sdkUpdate, to give resource time until it creates
-	if desired.ko.Spec.TimeToLive != nil || desired.ko.Spec.ContributorInsights != nil {
+	// CreateTable does not accept replicas either; the base table is created
+	// here and the update path adds the replicas one at a time once the
+	// table (and its GSIs) are ACTIVE.
+	if desired.ko.Spec.TimeToLive != nil || desired.ko.Spec.ContributorInsights != nil ||
+		gsiContributorInsightsDefined(desired.ko.Spec.GlobalSecondaryIndexes) ||
+		desired.ko.Spec.KinesisStreamDestinations != nil ||
+		len(desired.ko.Spec.TableReplicas) > 0 {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
 	}
 
@@ -865,25 +1106,45 @@ func (rm *resourceManager) newCreateRequestPayload(
 				}
 				f3elem.KeySchema = f3elemf1
 			}
+			if f3iter.OnDemandThroughput != nil {
+				f3elemf2 := &svcsdktypes.OnDemandThroughput{}
+				if f3iter.OnDemandThroughput.MaxReadRequestUnits != nil {
+					f3elemf2.MaxReadRequestUnits = f3iter.OnDemandThroughput.MaxReadRequestUnits
+				}
+				if f3iter.OnDemandThroughput.MaxWriteRequestUnits != nil {
+					f3elemf2.MaxWriteRequestUnits = f3iter.OnDemandThroughput.MaxWriteRequestUnits
+				}
+				f3elem.OnDemandThroughput = f3elemf2
+			}
 			if f3iter.Projection != nil {
-				f3elemf2 := &svcsdktypes.Projection{}
+				f3elemf3 := &svcsdktypes.Projection{}
 				if f3iter.Projection.NonKeyAttributes != nil {
-					f3elemf2.NonKeyAttributes = aws.ToStringSlice(f3iter.Projection.NonKeyAttributes)
+					f3elemf3.NonKeyAttributes = aws.ToStringSlice(f3iter.Projection.NonKeyAttributes)
 				}
 				if f3iter.Projection.ProjectionType != nil {
-					f3elemf2.ProjectionType = svcsdktypes.ProjectionType(*f3iter.Projection.ProjectionType)
+					f3elemf3.ProjectionType = svcsdktypes.ProjectionType(*f3iter.Projection.ProjectionType)
 				}
-				f3elem.Projection = f3elemf2
+				f3elem.Projection = f3elemf3
 			}
 			if f3iter.ProvisionedThroughput != nil {
-				f3elemf3 := &svcsdktypes.ProvisionedThroughput{}
+				f3elemf4 := &svcsdktypes.ProvisionedThroughput{}
 				if f3iter.ProvisionedThroughput.ReadCapacityUnits != nil {
-					f3elemf3.ReadCapacityUnits = f3iter.ProvisionedThroughput.ReadCapacityUnits
+					f3elemf4.ReadCapacityUnits = f3iter.ProvisionedThroughput.ReadCapacityUnits
 				}
 				if f3iter.ProvisionedThroughput.WriteCapacityUnits != nil {
-					f3elemf3.WriteCapacityUnits = f3iter.ProvisionedThroughput.WriteCapacityUnits
+					f3elemf4.WriteCapacityUnits = f3iter.ProvisionedThroughput.WriteCapacityUnits
+				}
+				f3elem.ProvisionedThroughput = f3elemf4
+			}
+			if f3iter.WarmThroughput != nil {
+				f3elemf5 := &svcsdktypes.WarmThroughput{}
+				if f3iter.WarmThroughput.ReadUnitsPerSecond != nil {
+					f3elemf5.ReadUnitsPerSecond = f3iter.WarmThroughput.ReadUnitsPerSecond
+				}
+				if f3iter.WarmThroughput.WriteUnitsPerSecond != nil {
+					f3elemf5.WriteUnitsPerSecond = f3iter.WarmThroughput.WriteUnitsPerSecond
 				}
-				f3elem.ProvisionedThroughput = f3elemf3
+				f3elem.WarmThroughput = f3elemf5
 			}
 			f3 = append(f3, *f3elem)
 		}
@@ -938,38 +1199,51 @@ func (rm *resourceManager) newCreateRequestPayload(
 		}
 		res.LocalSecondaryIndexes = f5
 	}
+	if r.ko.Spec.OnDemandThroughput != nil {
+		f6 := &svcsdktypes.OnDemandThroughput{}
+		if r.ko.Spec.OnDemandThroughput.MaxReadRequestUnits != nil {
+			f6.MaxReadRequestUnits = r.ko.Spec.OnDemandThroughput.MaxReadRequestUnits
+		}
+		if r.ko.Spec.OnDemandThroughput.MaxWriteRequestUnits != nil {
+			f6.MaxWriteRequestUnits = r.ko.Spec.OnDemandThroughput.MaxWriteRequestUnits
+		}
+		res.OnDemandThroughput = f6
+	}
 	if r.ko.Spec.ProvisionedThroughput != nil {
-		f6 := &svcsdktypes.ProvisionedThroughput{}
+		f7 := &svcsdktypes.ProvisionedThroughput{}
 		if r.ko.Spec.ProvisionedThroughput.ReadCapacityUnits != nil {
-			f6.ReadCapacityUnits = r.ko.Spec.ProvisionedThroughput.ReadCapacityUnits
+			f7.ReadCapacityUnits = r.ko.Spec.ProvisionedThroughput.ReadCapacityUnits
 		}
 		if r.ko.Spec.ProvisionedThroughput.WriteCapacityUnits != nil {
-			f6.WriteCapacityUnits = r.ko.Spec.ProvisionedThroughput.WriteCapacityUnits
+			f7.WriteCapacityUnits = r.ko.Spec.ProvisionedThroughput.WriteCapacityUnits
 		}
-		res.ProvisionedThroughput = f6
+		res.ProvisionedThroughput = f7
+	}
+	if r.ko.Spec.ResourcePolicy != nil {
+		res.ResourcePolicy = r.ko.Spec.ResourcePolicy
 	}
 	if r.ko.Spec.SSESpecification != nil {
-		f7 := &svcsdktypes.SSESpecification{}
+		f9 := &svcsdktypes.SSESpecification{}
 		if r.ko.Spec.SSESpecification.Enabled != nil {
-			f7.Enabled = r.ko.Spec.SSESpecification.Enabled
+			f9.Enabled = r.ko.Spec.SSESpecification.Enabled
 		}
 		if r.ko.Spec.SSESpecification.KMSMasterKeyID != nil {
-			f7.KMSMasterKeyId = r.ko.Spec.SSESpecification.KMSMasterKeyID
+			f9.KMSMasterKeyId = r.ko.Spec.SSESpecification.KMSMasterKeyID
 		}
 		if r.ko.Spec.SSESpecification.SSEType != nil {
-			f7.SSEType = svcsdktypes.SSEType(*r.ko.Spec.SSESpecification.SSEType)
+			f9.SSEType = svcsdktypes.SSEType(*r.ko.Spec.SSESpecification.SSEType)
 		}
-		res.SSESpecification = f7
+		res.SSESpecification = f9
 	}
 	if r.ko.Spec.StreamSpecification != nil {
-		f8 := &svcsdktypes.StreamSpecification{}
+		f10 := &svcsdktypes.StreamSpecification{}
 		if r.ko.Spec.StreamSpecification.StreamEnabled != nil {
-			f8.StreamEnabled = r.ko.Spec.StreamSpecification.StreamEnabled
+			f10.StreamEnabled = r.ko.Spec.StreamSpecification.StreamEnabled
 		}
 		if r.ko.Spec.StreamSpecification.StreamViewType != nil {
-			f8.StreamViewType = svcsdktypes.StreamViewType(*r.ko.Spec.StreamSpecification.StreamViewType)
+			f10.StreamViewType = svcsdktypes.StreamViewType(*r.ko.Spec.StreamSpecification.StreamViewType)
 		}
-		res.StreamSpecification = f8
+		res.StreamSpecification = f10
 	}
 	if r.ko.Spec.TableClass != nil {
 		res.TableClass = svcsdktypes.TableClass(*r.ko.Spec.TableClass)
@@ -978,18 +1252,28 @@ func (rm *resourceManager) newCreateRequestPayload(
 		res.TableName = r.ko.Spec.TableName
 	}
 	if r.ko.Spec.Tags != nil {
-		f11 := []svcsdktypes.Tag{}
-		for _, f11iter := range r.ko.Spec.Tags {
-			f11elem := &svcsdktypes.Tag{}
-			if f11iter.Key != nil {
-				f11elem.Key = f11iter.Key
+		f13 := []svcsdktypes.Tag{}
+		for _, f13iter := range r.ko.Spec.Tags {
+			f13elem := &svcsdktypes.Tag{}
+			if f13iter.Key != nil {
+				f13elem.Key = f13iter.Key
 			}
-			if f11iter.Value != nil {
-				f11elem.Value = f11iter.Value
+			if f13iter.Value != nil {
+				f13elem.Value = f13iter.Value
 			}
-			f11 = append(f11, *f11elem)
+			f13 = append(f13, *f13elem)
+		}
+		res.Tags = f13
+	}
+	if r.ko.Spec.WarmThroughput != nil {
+		f14 := &svcsdktypes.WarmThroughput{}
+		if r.ko.Spec.WarmThroughput.ReadUnitsPerSecond != nil {
+			f14.ReadUnitsPerSecond = r.ko.Spec.WarmThroughput.ReadUnitsPerSecond
+		}
+		if r.ko.Spec.WarmThroughput.WriteUnitsPerSecond != nil {
+			f14.WriteUnitsPerSecond = r.ko.Spec.WarmThroughput.WriteUnitsPerSecond
 		}
-		res.Tags = f11
+		res.WarmThroughput = f14
 	}
 
 	return res, nil
@@ -1016,11 +1300,78 @@ func (rm *resourceManager) sdkDelete(
 	defer func() {
 		exit(err)
 	}()
+	// The ACK runtime removes the finalizer without calling Delete when the
+	// deletion policy is retain; this guard keeps the actual table safe even
+	// if sdkDelete is reached through another path, and records which table
+	// was orphaned.
+	if deletionPolicyIsRetain(r) {
+		arn := ""
+		if r.ko.Status.ACKResourceMetadata != nil && r.ko.Status.ACKResourceMetadata.ARN != nil {
+			arn = string(*r.ko.Status.ACKResourceMetadata.ARN)
+		}
+		rlog.Info("orphaning table instead of deleting it - deletion policy set to retain", "arn", arn)
+		return nil, nil
+	}
 	if isTableDeleting(r) {
 		return nil, requeueWaitWhileDeleting
 	}
 	if isTableUpdating(r) {
-		return nil, requeueWaitWhileUpdating
+		return nil, overrideRequeueDelay(r, requeueWaitWhileUpdating)
+	}
+	// An archived table no longer exists as a live table - only its archival
+	// backup does - so there is nothing left for DeleteTable to remove.
+	// Treat the deletion as done so the finalizer comes off cleanly.
+	if isTableArchived(r) {
+		rlog.Info("table is archived, nothing to delete", "message", terminalStatusMessage(r))
+		return nil, nil
+	}
+	if isTableArchiving(r) {
+		return nil, requeueWaitWhileArchiving
+	}
+
+	// Cap the number of simultaneous mutating operations across all tables;
+	// everything below (final backup, replica teardown, DeleteTable) issues
+	// mutations.
+	releaseMutationSlot, ok := acquireMutationSlot(r)
+	if !ok {
+		msg := "waiting for a concurrent table mutation slot"
+		setSyncedCondition(r, corev1.ConditionFalse, &msg, nil)
+		return r, requeueWaitMutationSlot
+	}
+	defer releaseMutationSlot()
+
+	// When the final-backup-name annotation is set, the table's data is
+	// backed up before anything is torn down, and DeleteTable waits until
+	// the backup reaches AVAILABLE. The skip-final-backup annotation lets
+	// the deletion proceed without it.
+	if name, ok := finalBackupName(r); ok && !skipFinalBackup(r) {
+		available, err := rm.ensureFinalBackup(ctx, r, name)
+		if err != nil {
+			return nil, err
+		}
+		if !available {
+			msg := fmt.Sprintf(
+				"waiting for final backup %q to become available before deleting the table", name,
+			)
+			setSyncedCondition(r, corev1.ConditionFalse, &msg, nil)
+			return r, requeueWaitFinalBackup
+		}
+	}
+
+	// Deletion protection blocks DeleteTable. If the user opted in via the
+	// force-delete-protection annotation we disable it first; otherwise we
+	// surface a terminal error instead of looping on the ValidationException
+	// that DeleteTable returns.
+	if aws.ToBool(r.ko.Spec.DeletionProtectionEnabled) {
+		if !canForceDeleteProtection(r) {
+			msg := "table has deletion protection enabled; disable it in the " +
+				"spec or set the " + AnnotationForceDeleteProtection +
+				"=disable annotation to let the controller disable it"
+			return nil, ackerr.NewTerminalError(errors.New(msg))
+		}
+		if err := rm.disableDeletionProtection(ctx, r); err != nil {
+			return nil, err
+		}
 	}
 
 	// If there are replicas, we need to remove them before deleting the table
@@ -1034,9 +1385,12 @@ func (rm *resourceManager) sdkDelete(
 		if err != nil {
 			return nil, err
 		}
-		// Requeue to wait for replica removal to complete before attempting table deletion
-		// When syncReplicas returns an error other than requeue
-		return r, requeueWaitWhileDeleting
+		// Requeue to wait for replica removal to complete before attempting
+		// table deletion. The synced condition makes it visible on the CR
+		// that the controller is tearing down replicas, not stuck.
+		msg := "deleting table replicas before deleting the table"
+		setSyncedCondition(r, corev1.ConditionFalse, &msg, nil)
+		return r, requeueWaitReplicasDeleting
 	}
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
@@ -1046,6 +1400,28 @@ func (rm *resourceManager) sdkDelete(
 	_ = resp
 	resp, err = rm.sdkapi.DeleteTable(ctx, input)
 	rm.metrics.RecordAPICall("DELETE", "DeleteTable", err)
+	recordSDKCall("DeleteTable", err)
+	if err != nil && isResourceNotFoundError(err) {
+		// The table was already deleted out of band; treat the delete as
+		// successful so the finalizer is removed and the namespace can
+		// terminate, instead of retrying a delete that can never succeed.
+		rlog.Info("table already deleted out of band", "table", aws.ToString(r.ko.Spec.TableName))
+		forgetTableMetrics(r)
+		return nil, nil
+	}
+	if err != nil && isThrottlingError(err) {
+		rlog.Info("throttled by DynamoDB control plane", "error", err)
+		return nil, throttleRequeue(err)
+	}
+	if err != nil && classifyAPIError(err) != errorClassRetryNormal {
+		// Terminal errors (e.g. a missing IAM permission) stop the retry
+		// loop with the AWS request ID in the condition message; a busy
+		// table requeues quickly instead.
+		return nil, mapAPIError(err)
+	}
+	if err == nil {
+		forgetTableMetrics(r)
+	}
 	return nil, err
 }
 
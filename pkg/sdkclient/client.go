@@ -0,0 +1,111 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package sdkclient constructs the DynamoDB API client used by the resource
+// managers, with optional per-call timeout and retry behavior configured via
+// controller flags. With no flags set the client behaves exactly like a
+// plain svcsdk.NewFromConfig.
+package sdkclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/smithy-go/middleware"
+	flag "github.com/spf13/pflag"
+)
+
+var (
+	// apiTimeout bounds every individual DynamoDB API call. Zero leaves the
+	// SDK default (no per-call deadline beyond the reconcile context).
+	apiTimeout time.Duration
+	// maxRetryAttempts caps how often the SDK retries a throttled or
+	// transient failure. Zero leaves the SDK default.
+	maxRetryAttempts int
+	// maxRetryBackoff caps the delay between two retry attempts. Zero
+	// leaves the SDK default.
+	maxRetryBackoff time.Duration
+)
+
+// BindFlags registers the DynamoDB client tuning flags on the given flag
+// set. It is called once from the controller entry point before flag
+// parsing.
+func BindFlags(fs *flag.FlagSet) {
+	fs.DurationVar(
+		&apiTimeout, "dynamodb-api-timeout", 0,
+		"Per-call timeout for DynamoDB API requests; a hung call fails fast "+
+			"and the resource is requeued. Zero keeps the SDK default of no "+
+			"per-call deadline.",
+	)
+	fs.IntVar(
+		&maxRetryAttempts, "dynamodb-max-retry-attempts", 0,
+		"Maximum number of attempts the SDK makes per DynamoDB API call, "+
+			"including the first one. Zero keeps the SDK default.",
+	)
+	fs.DurationVar(
+		&maxRetryBackoff, "dynamodb-max-retry-backoff", 0,
+		"Maximum delay between two retry attempts of a DynamoDB API call. "+
+			"Zero keeps the SDK default.",
+	)
+}
+
+// NewFromConfig returns a DynamoDB client built from the given AWS config
+// with the configured per-call timeout and retryer applied, followed by any
+// caller-supplied option functions.
+func NewFromConfig(cfg aws.Config, optFns ...func(*svcsdk.Options)) *svcsdk.Client {
+	fns := []func(*svcsdk.Options){applyClientFlags}
+	fns = append(fns, optFns...)
+	return svcsdk.NewFromConfig(cfg, fns...)
+}
+
+// applyClientFlags translates the bound flag values into client options. It
+// leaves every option the flags did not set untouched so that the defaults
+// match an unconfigured client.
+func applyClientFlags(o *svcsdk.Options) {
+	if apiTimeout > 0 {
+		o.APIOptions = append(o.APIOptions, withOperationTimeout(apiTimeout))
+	}
+	if maxRetryAttempts > 0 || maxRetryBackoff > 0 {
+		o.Retryer = retry.NewStandard(func(so *retry.StandardOptions) {
+			if maxRetryAttempts > 0 {
+				so.MaxAttempts = maxRetryAttempts
+			}
+			if maxRetryBackoff > 0 {
+				so.MaxBackoff = maxRetryBackoff
+			}
+		})
+	}
+}
+
+// withOperationTimeout returns a middleware registration that bounds a
+// single API operation - including signing, the HTTP round trip and
+// response deserialization - by the given duration. The deadline applies
+// per call, so a hung request surfaces as a context deadline error instead
+// of stalling the reconcile worker.
+func withOperationTimeout(d time.Duration) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(
+			"ACKOperationTimeout",
+			func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+				middleware.InitializeOutput, middleware.Metadata, error,
+			) {
+				ctx, cancel := context.WithTimeout(ctx, d)
+				defer cancel()
+				return next.HandleInitialize(ctx, in)
+			},
+		), middleware.Before)
+	}
+}
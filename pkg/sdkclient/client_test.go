@@ -0,0 +1,111 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package sdkclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+)
+
+// slowHTTPClient answers every request with an empty JSON body after the
+// configured delay, or with the context's error if the request is canceled
+// first. It stands in for a regional event where the control plane hangs.
+type slowHTTPClient struct {
+	delay time.Duration
+}
+
+func (c *slowHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	select {
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	case <-time.After(c.delay):
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader("{}")),
+		}, nil
+	}
+}
+
+func testConfig(delay time.Duration) aws.Config {
+	return aws.Config{
+		Region:      "us-west-2",
+		Credentials: aws.AnonymousCredentials{},
+		HTTPClient:  &slowHTTPClient{delay: delay},
+	}
+}
+
+func setFlags(t *testing.T, timeout time.Duration, attempts int, backoff time.Duration) {
+	t.Helper()
+	prevTimeout, prevAttempts, prevBackoff := apiTimeout, maxRetryAttempts, maxRetryBackoff
+	t.Cleanup(func() {
+		apiTimeout, maxRetryAttempts, maxRetryBackoff = prevTimeout, prevAttempts, prevBackoff
+	})
+	apiTimeout, maxRetryAttempts, maxRetryBackoff = timeout, attempts, backoff
+}
+
+func TestNewFromConfig_perCallTimeout(t *testing.T) {
+	setFlags(t, 100*time.Millisecond, 0, 0)
+	client := NewFromConfig(testConfig(5 * time.Second))
+
+	start := time.Now()
+	_, err := client.DescribeTable(context.Background(), &svcsdk.DescribeTableInput{
+		TableName: aws.String("table"),
+	})
+	elapsed := time.Since(start)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want a context deadline error", err)
+	}
+	if elapsed >= time.Second {
+		t.Errorf("call took %v, want it to fail fast on the per-call timeout", elapsed)
+	}
+}
+
+func TestNewFromConfig_noTimeoutByDefault(t *testing.T) {
+	setFlags(t, 0, 0, 0)
+	client := NewFromConfig(testConfig(50 * time.Millisecond))
+
+	if _, err := client.DescribeTable(context.Background(), &svcsdk.DescribeTableInput{
+		TableName: aws.String("table"),
+	}); err != nil {
+		t.Fatalf("unexpected error without a configured timeout: %v", err)
+	}
+}
+
+func TestNewFromConfig_retryerFlags(t *testing.T) {
+	setFlags(t, 0, 2, time.Second)
+	client := NewFromConfig(testConfig(0))
+	if got := client.Options().Retryer.MaxAttempts(); got != 2 {
+		t.Errorf("retryer MaxAttempts = %d, want 2", got)
+	}
+}
+
+func TestNewFromConfig_callerOptionsStillApply(t *testing.T) {
+	setFlags(t, 0, 0, 0)
+	client := NewFromConfig(testConfig(0), func(o *svcsdk.Options) {
+		o.Region = "eu-west-1"
+	})
+	if got := client.Options().Region; got != "eu-west-1" {
+		t.Errorf("region = %q, want the caller's option applied", got)
+	}
+}
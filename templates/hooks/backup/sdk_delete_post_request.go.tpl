@@ -0,0 +1,6 @@
+	if err != nil && isBackupNotFoundError(err) {
+		// The backup was already deleted out of band; treat the delete as
+		// successful so the finalizer is removed.
+		rlog.Info("backup already deleted out of band")
+		return nil, nil
+	}
@@ -0,0 +1,12 @@
+	// The ACK runtime removes the finalizer without calling Delete when the
+	// deletion policy is retain; this guard keeps the actual backup safe even
+	// if sdkDelete is reached through another path, and records which backup
+	// was orphaned.
+	if deletionPolicyIsRetain(r) {
+		arn := ""
+		if r.ko.Status.ACKResourceMetadata != nil && r.ko.Status.ACKResourceMetadata.ARN != nil {
+			arn = string(*r.ko.Status.ACKResourceMetadata.ARN)
+		}
+		rlog.Info("orphaning backup instead of deleting it - deletion policy set to retain", "arn", arn)
+		return nil, nil
+	}
@@ -1,3 +1,22 @@
+	// DescribeBackup also returns the source table's shape and the features
+	// enabled on it when the backup was taken; surface them so the right
+	// backup for a restore can be picked from the CR alone.
+	setSourceTableDetails(ko, resp.BackupDescription)
+	// Tags do not come back on DescribeBackup; list them separately so the
+	// delta against the spec can be computed. The listing is skipped until
+	// the spec manages tags and the backup's ARN accepts tagging calls.
+	if len(ko.Spec.Tags) > 0 && ko.Status.ACKResourceMetadata.ARN != nil &&
+		isBackupAvailable(&resource{ko}) {
+		tags, err := rm.getBackupTags(ctx, string(*ko.Status.ACKResourceMetadata.ARN))
+		if err != nil {
+			return nil, err
+		}
+		ko.Spec.Tags = tags
+	}
 	if isBackupCreating(&resource{ko}) {
 		return &resource{ko}, requeueWaitWhileCreating
-	}
\ No newline at end of file
+	}
+	if isBackupDeleted(&resource{ko}) {
+		msg := "backup is no longer available: status is DELETED"
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	}
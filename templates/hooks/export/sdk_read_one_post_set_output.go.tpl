@@ -0,0 +1,12 @@
+	rm.setStatusDefaults(ko)
+	if isExportInProgress(&resource{ko}) {
+		return &resource{ko}, requeueWaitWhileExporting
+	}
+	if exportHasFailed(&resource{ko}) {
+		msg := fmt.Sprintf(
+			"export failed: %s: %s",
+			aws.ToString(ko.Status.FailureCode),
+			aws.ToString(ko.Status.FailureMessage),
+		)
+		ackcondition.SetTerminal(&resource{ko}, corev1.ConditionTrue, &msg, nil)
+	}
@@ -0,0 +1,3 @@
+	// Surface each replica's observed status so the progress of
+	// replication group changes is visible on the CR.
+	setReplicationGroupDescriptions(ko, resp.GlobalTableDescription.ReplicationGroup)
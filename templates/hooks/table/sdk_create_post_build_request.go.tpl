@@ -0,0 +1,4 @@
+	// PAY_PER_REQUEST tables have no provisioned capacity to configure; drop
+	// any stored throughput values from the create call (warning once about
+	// the ignored fields) instead of rejecting the spec.
+	stripOnDemandIgnoredThroughput(input, desired)
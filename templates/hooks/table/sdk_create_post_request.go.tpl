@@ -0,0 +1,23 @@
+	recordSDKCall("CreateTable", err)
+	if err != nil && isResourceInUseException(err) && hasAdoptOrCreatePolicy(desired) {
+		// The table already exists and the user asked for it to be adopted:
+		// read it back (including GSIs, TTL, PITR, tags and stream settings)
+		// and hand it to the normal drift reconciliation instead of erroring.
+		return rm.sdkFind(ctx, desired)
+	}
+	if err != nil && isResourceInUseException(err) && rm.isTableDeletingServerSide(ctx, desired) {
+		// A previous table with the same name is still being deleted; wait
+		// for the name to free up and retry the create instead of surfacing
+		// an error.
+		return nil, requeueWaitWhileDeleting
+	}
+	if err != nil && isThrottlingError(err) {
+		rlog.Info("throttled by DynamoDB control plane", "error", err)
+		return nil, throttleRequeue(err)
+	}
+	if err != nil && classifyAPIError(err) != errorClassRetryNormal {
+		// Classify anything else centrally: IAM and validation failures go
+		// terminal (with the AWS request ID in the condition message) and
+		// busy-table errors requeue quickly.
+		return nil, mapAPIError(err)
+	}
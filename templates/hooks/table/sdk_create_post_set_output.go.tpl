@@ -1,4 +1,15 @@
+	// CreateTable already returns the descriptions of the secondary indexes
+	// (including their ARNs) alongside the table and stream ARNs; record
+	// them now so the status is complete on the first post-create reconcile
+	// instead of waiting for the next read.
+	ko.Status.GlobalSecondaryIndexesDescriptions = gsiDescriptionsFromSDK(resp.TableDescription.GlobalSecondaryIndexes)
 	// handle in sdkUpdate, to give resource time until it creates
-	if desired.ko.Spec.TimeToLive != nil || desired.ko.Spec.ContributorInsights != nil {
+	// CreateTable does not accept replicas either; the base table is created
+	// here and the update path adds the replicas one at a time once the
+	// table (and its GSIs) are ACTIVE.
+	if desired.ko.Spec.TimeToLive != nil || desired.ko.Spec.ContributorInsights != nil ||
+		gsiContributorInsightsDefined(desired.ko.Spec.GlobalSecondaryIndexes) ||
+		desired.ko.Spec.KinesisStreamDestinations != nil ||
+		len(desired.ko.Spec.TableReplicas) > 0 {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
 	}
@@ -0,0 +1,23 @@
+	// Default the table name from the Kubernetes object name so manifests can
+	// omit spec.tableName; the defaulted value is written back into the spec
+	// where the immutability check keeps guarding it.
+	defaultTableName(desired)
+	if err := validateTableSpecLimits(desired); err != nil {
+		return nil, err
+	}
+	// Cap the number of simultaneous mutating operations across all tables;
+	// the create (or restore/import) call below is a mutation.
+	releaseMutationSlot, ok := acquireMutationSlot(desired)
+	if !ok {
+		return nil, requeueWaitMutationSlot
+	}
+	defer releaseMutationSlot()
+	if desired.ko.Spec.RestoreSourceBackupARN != nil {
+		return rm.restoreTableFromBackup(ctx, desired)
+	}
+	if desired.ko.Spec.RestoreFrom != nil {
+		return rm.restoreTableToPointInTime(ctx, desired)
+	}
+	if desired.ko.Spec.ImportSourceSpecification != nil {
+		return rm.importTable(ctx, desired)
+	}
@@ -0,0 +1,22 @@
+	recordSDKCall("DeleteTable", err)
+	if err != nil && isResourceNotFoundError(err) {
+		// The table was already deleted out of band; treat the delete as
+		// successful so the finalizer is removed and the namespace can
+		// terminate, instead of retrying a delete that can never succeed.
+		rlog.Info("table already deleted out of band", "table", aws.ToString(r.ko.Spec.TableName))
+		forgetTableMetrics(r)
+		return nil, nil
+	}
+	if err != nil && isThrottlingError(err) {
+		rlog.Info("throttled by DynamoDB control plane", "error", err)
+		return nil, throttleRequeue(err)
+	}
+	if err != nil && classifyAPIError(err) != errorClassRetryNormal {
+		// Terminal errors (e.g. a missing IAM permission) stop the retry
+		// loop with the AWS request ID in the condition message; a busy
+		// table requeues quickly instead.
+		return nil, mapAPIError(err)
+	}
+	if err == nil {
+		forgetTableMetrics(r)
+	}
@@ -1,8 +1,75 @@
+	// The ACK runtime removes the finalizer without calling Delete when the
+	// deletion policy is retain; this guard keeps the actual table safe even
+	// if sdkDelete is reached through another path, and records which table
+	// was orphaned.
+	if deletionPolicyIsRetain(r) {
+		arn := ""
+		if r.ko.Status.ACKResourceMetadata != nil && r.ko.Status.ACKResourceMetadata.ARN != nil {
+			arn = string(*r.ko.Status.ACKResourceMetadata.ARN)
+		}
+		rlog.Info("orphaning table instead of deleting it - deletion policy set to retain", "arn", arn)
+		return nil, nil
+	}
 	if isTableDeleting(r) {
 		return nil, requeueWaitWhileDeleting
 	}
 	if isTableUpdating(r) {
-		return nil, requeueWaitWhileUpdating
+		return nil, overrideRequeueDelay(r, requeueWaitWhileUpdating)
+	}
+	// An archived table no longer exists as a live table - only its archival
+	// backup does - so there is nothing left for DeleteTable to remove.
+	// Treat the deletion as done so the finalizer comes off cleanly.
+	if isTableArchived(r) {
+		rlog.Info("table is archived, nothing to delete", "message", terminalStatusMessage(r))
+		return nil, nil
+	}
+	if isTableArchiving(r) {
+		return nil, requeueWaitWhileArchiving
+	}
+
+	// Cap the number of simultaneous mutating operations across all tables;
+	// everything below (final backup, replica teardown, DeleteTable) issues
+	// mutations.
+	releaseMutationSlot, ok := acquireMutationSlot(r)
+	if !ok {
+		msg := "waiting for a concurrent table mutation slot"
+		setSyncedCondition(r, corev1.ConditionFalse, &msg, nil)
+		return r, requeueWaitMutationSlot
+	}
+	defer releaseMutationSlot()
+
+	// When the final-backup-name annotation is set, the table's data is
+	// backed up before anything is torn down, and DeleteTable waits until
+	// the backup reaches AVAILABLE. The skip-final-backup annotation lets
+	// the deletion proceed without it.
+	if name, ok := finalBackupName(r); ok && !skipFinalBackup(r) {
+		available, err := rm.ensureFinalBackup(ctx, r, name)
+		if err != nil {
+			return nil, err
+		}
+		if !available {
+			msg := fmt.Sprintf(
+				"waiting for final backup %q to become available before deleting the table", name,
+			)
+			setSyncedCondition(r, corev1.ConditionFalse, &msg, nil)
+			return r, requeueWaitFinalBackup
+		}
+	}
+
+	// Deletion protection blocks DeleteTable. If the user opted in via the
+	// force-delete-protection annotation we disable it first; otherwise we
+	// surface a terminal error instead of looping on the ValidationException
+	// that DeleteTable returns.
+	if aws.ToBool(r.ko.Spec.DeletionProtectionEnabled) {
+		if !canForceDeleteProtection(r) {
+			msg := "table has deletion protection enabled; disable it in the " +
+				"spec or set the " + AnnotationForceDeleteProtection +
+				"=disable annotation to let the controller disable it"
+			return nil, ackerr.NewTerminalError(errors.New(msg))
+		}
+		if err := rm.disableDeletionProtection(ctx, r); err != nil {
+			return nil, err
+		}
 	}
 
 	// If there are replicas, we need to remove them before deleting the table
@@ -16,7 +83,10 @@
 		if err != nil {
 			return nil, err
 		}
-		// Requeue to wait for replica removal to complete before attempting table deletion
-		// When syncReplicas returns an error other than requeue
-		return r, requeueWaitWhileDeleting
+		// Requeue to wait for replica removal to complete before attempting
+		// table deletion. The synced condition makes it visible on the CR
+		// that the controller is tearing down replicas, not stuck.
+		msg := "deleting table replicas before deleting the table"
+		setSyncedCondition(r, corev1.ConditionFalse, &msg, nil)
+		return r, requeueWaitReplicasDeleting
 	}
\ No newline at end of file
@@ -1,30 +1,33 @@
-	if resp.Table.GlobalSecondaryIndexes != nil {
-		f := []*svcapitypes.GlobalSecondaryIndexDescription{}
-		for _, fIter := range resp.Table.GlobalSecondaryIndexes {
-			fElem := &svcapitypes.GlobalSecondaryIndexDescription{}
+	if isTableDeleting(&resource{ko}) {
+		// A table in DELETING status is on its way out; report it as not
+		// found so a pending recreate waits for the name to free up instead
+		// of diffing against the doomed table.
+		return nil, ackerr.NotFound
+	}
+	// Record every index's description - including its ARN, which
+	// FieldExport consumers rely on - each time the table is read.
+	ko.Status.GlobalSecondaryIndexesDescriptions = gsiDescriptionsFromSDK(resp.Table.GlobalSecondaryIndexes)
+	if resp.Table.LocalSecondaryIndexes != nil {
+		f := []*svcapitypes.LocalSecondaryIndexDescription{}
+		for _, fIter := range resp.Table.LocalSecondaryIndexes {
+			fElem := &svcapitypes.LocalSecondaryIndexDescription{}
 			if fIter.IndexName != nil {
 				fElem.IndexName = fIter.IndexName
 			}
 			if fIter.IndexArn != nil {
 				fElem.IndexARN = fIter.IndexArn
 			}
-			if fIter.ItemCount != nil {
-				fElem.ItemCount = fIter.ItemCount
-			}
-			if fIter.IndexStatus != "" {
-				fElem.IndexStatus = aws.String(string(fIter.IndexStatus))
-			}
 			if fIter.IndexSizeBytes != nil {
 				fElem.IndexSizeBytes = fIter.IndexSizeBytes
 			}
-			if fIter.Backfilling != nil {
-				fElem.Backfilling = fIter.Backfilling
+			if fIter.ItemCount != nil {
+				fElem.ItemCount = fIter.ItemCount
 			}
 			f = append(f, fElem)
 		}
-		ko.Status.GlobalSecondaryIndexesDescriptions = f
+		ko.Status.LocalSecondaryIndexesDescriptions = f
 	} else {
-		ko.Status.GlobalSecondaryIndexesDescriptions = nil
+		ko.Status.LocalSecondaryIndexesDescriptions = nil
 	}
 	if resp.Table.SSEDescription != nil {
 		f := &svcapitypes.SSESpecification{}
@@ -40,33 +43,135 @@
 			f.KMSMasterKeyID = resp.Table.SSEDescription.KMSMasterKeyArn
 		}
 		ko.Spec.SSESpecification = f
+		f1 := &svcapitypes.SSEDescription{}
+		if resp.Table.SSEDescription.Status != "" {
+			f1.Status = aws.String(string(resp.Table.SSEDescription.Status))
+		}
+		if resp.Table.SSEDescription.SSEType != "" {
+			f1.SSEType = aws.String(string(resp.Table.SSEDescription.SSEType))
+		}
+		if resp.Table.SSEDescription.KMSMasterKeyArn != nil {
+			f1.KMSMasterKeyARN = resp.Table.SSEDescription.KMSMasterKeyArn
+		}
+		if resp.Table.SSEDescription.InaccessibleEncryptionDateTime != nil {
+			f1.InaccessibleEncryptionDateTime = &metav1.Time{*resp.Table.SSEDescription.InaccessibleEncryptionDateTime}
+		}
+		ko.Status.SSEDescription = f1
 	} else {
 		ko.Spec.SSESpecification = nil
+		ko.Status.SSEDescription = nil
 	}
 	if resp.Table.TableClassSummary != nil {
+		f := &svcapitypes.TableClassSummary{}
+		if resp.Table.TableClassSummary.LastUpdateDateTime != nil {
+			f.LastUpdateDateTime = &metav1.Time{*resp.Table.TableClassSummary.LastUpdateDateTime}
+		}
+		if resp.Table.TableClassSummary.TableClass != "" {
+			f.TableClass = aws.String(string(resp.Table.TableClassSummary.TableClass))
+		}
+		ko.Status.TableClassSummary = f
 		ko.Spec.TableClass = aws.String(string(resp.Table.TableClassSummary.TableClass))
 	} else {
+		ko.Status.TableClassSummary = nil
 		ko.Spec.TableClass = aws.String("STANDARD")
 	}
+	if resp.Table.BillingModeSummary != nil {
+		f := &svcapitypes.BillingModeSummary{}
+		if resp.Table.BillingModeSummary.BillingMode != "" {
+			f.BillingMode = aws.String(string(resp.Table.BillingModeSummary.BillingMode))
+		}
+		if resp.Table.BillingModeSummary.LastUpdateToPayPerRequestDateTime != nil {
+			f.LastUpdateToPayPerRequestDateTime = &metav1.Time{*resp.Table.BillingModeSummary.LastUpdateToPayPerRequestDateTime}
+		}
+		ko.Status.BillingModeSummary = f
+	} else {
+		ko.Status.BillingModeSummary = nil
+	}
 	if resp.Table.BillingModeSummary != nil && resp.Table.BillingModeSummary.BillingMode != "" {
 		ko.Spec.BillingMode = aws.String(string(resp.Table.BillingModeSummary.BillingMode))
 	} else {
 		ko.Spec.BillingMode = aws.String("PROVISIONED")
 	}
+	// Mirror the authoritative deletion protection state and the provisioned
+	// throughput description for drift auditing; the spec-side fields only
+	// carry the desired values.
+	ko.Status.DeletionProtectionEnabled = resp.Table.DeletionProtectionEnabled
+	if resp.Table.ProvisionedThroughput != nil {
+		f := &svcapitypes.ProvisionedThroughputDescription{}
+		if resp.Table.ProvisionedThroughput.LastDecreaseDateTime != nil {
+			f.LastDecreaseDateTime = &metav1.Time{*resp.Table.ProvisionedThroughput.LastDecreaseDateTime}
+		}
+		if resp.Table.ProvisionedThroughput.LastIncreaseDateTime != nil {
+			f.LastIncreaseDateTime = &metav1.Time{*resp.Table.ProvisionedThroughput.LastIncreaseDateTime}
+		}
+		f.NumberOfDecreasesToday = resp.Table.ProvisionedThroughput.NumberOfDecreasesToday
+		f.ReadCapacityUnits = resp.Table.ProvisionedThroughput.ReadCapacityUnits
+		f.WriteCapacityUnits = resp.Table.ProvisionedThroughput.WriteCapacityUnits
+		ko.Status.ProvisionedThroughputDescription = f
+	} else {
+		ko.Status.ProvisionedThroughputDescription = nil
+	}
+	if resp.Table.MultiRegionConsistency != "" {
+		ko.Status.MultiRegionConsistency = aws.String(string(resp.Table.MultiRegionConsistency))
+	} else {
+		ko.Status.MultiRegionConsistency = nil
+	}
+	if resp.Table.WarmThroughput != nil {
+		wt := &svcapitypes.TableWarmThroughputDescription{}
+		if resp.Table.WarmThroughput.ReadUnitsPerSecond != nil {
+			wt.ReadUnitsPerSecond = resp.Table.WarmThroughput.ReadUnitsPerSecond
+		}
+		if resp.Table.WarmThroughput.WriteUnitsPerSecond != nil {
+			wt.WriteUnitsPerSecond = resp.Table.WarmThroughput.WriteUnitsPerSecond
+		}
+		if resp.Table.WarmThroughput.Status != "" {
+			wt.Status = aws.String(string(resp.Table.WarmThroughput.Status))
+		}
+		ko.Status.WarmThroughputDescription = wt
+	} else {
+		ko.Status.WarmThroughputDescription = nil
+	}
+	setGlobalSecondaryIndexWarmThroughputs(ko, resp.Table.GlobalSecondaryIndexes)
 	setTableReplicas(ko, resp.Table.Replicas)
+	trackTableTransition(&resource{ko})
+	checkTableProgressDeadline(&resource{ko})
+	if ko.Status.Import != nil && (ko.Status.Import.ImportStatus == nil ||
+		*ko.Status.Import.ImportStatus != string(svcsdktypes.ImportStatusCompleted)) {
+		if err := rm.syncImportStatus(ctx, &resource{ko}); err != nil {
+			return &resource{ko}, err
+		}
+	}
 	if isTableCreating(&resource{ko}) {
-		return &resource{ko}, requeueWaitWhileCreating
+		return &resource{ko}, overrideRequeueDelay(&resource{ko}, requeueWaitWhileCreating)
 	}
+	updateGSIsInSyncCondition(&resource{ko})
+	setTableWaitingGSIBackfill(&resource{ko}, !canUpdateTableGSIs(&resource{ko}))
 	if !canUpdateTableGSIs(&resource{ko}) {
-		return &resource{ko}, requeueWaitGSIReady
+		return &resource{ko}, overrideRequeueDelay(&resource{ko}, requeueWaitGSIReady)
+	}
+	if tableReplicasTransitioning(&resource{ko}) {
+		// ResourceSynced must not flip to True while replicas are still
+		// settling; keep requeueing until every replica reports ACTIVE.
+		msg := "table replicas are not yet ACTIVE"
+		setSyncedCondition(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+		return &resource{ko}, overrideRequeueDelay(&resource{ko}, requeueWaitReplicasActive)
 	}
 	if err = rm.setContributorInsights(ctx, ko); err != nil {
-		return &resource{ko}, err
+		return &resource{ko}, mapAPIError(err)
+	}
+	if err = rm.setKinesisStreamingDestinations(ctx, ko); err != nil {
+		return &resource{ko}, mapAPIError(err)
 	}
 	if isTableUpdating(&resource{ko}) || isTableContributorInsightsUpdating(&resource{ko}) {
-		return &resource{ko}, requeueWaitWhileUpdating
+		return &resource{ko}, overrideRequeueDelay(&resource{ko}, requeueWaitWhileUpdating)
+	}
+	if kinesisDestinationsTransitioning(&resource{ko}) {
+		return &resource{ko}, requeueWaitKinesisDestinationActive
 	}
 
 	if err := rm.setResourceAdditionalFields(ctx, ko); err != nil {
-		return nil, err
+		// The auxiliary reads go through the central error classification,
+		// so e.g. a missing IAM permission on DescribeTimeToLive goes
+		// terminal instead of retrying forever.
+		return nil, mapAPIError(err)
 	}
@@ -0,0 +1,3 @@
+	// Default the table name from the Kubernetes object name so a manifest
+	// that omits spec.tableName reads (and later creates) the right table.
+	defaultTableName(r)